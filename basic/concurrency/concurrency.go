@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+)
+
+func main() {
+	runRaces := flag.Bool("races", false, "run the deliberately racy examples (best with go run -race . -races)")
+	flag.Parse()
+
+	// -- 1. Goroutines --
+	// A function running concurrently with the rest of the program. Starts at a few KB of stack.
+	fmt.Println("\n\033[1;36m=== 1. GOROUTINES ===\033[0m")
+	fmt.Println("CPUs:", runtime.NumCPU(), "| GOMAXPROCS:", runtime.GOMAXPROCS(0), "| Goroutines now:", runtime.NumGoroutine())
+
+	var wg sync.WaitGroup
+	greetings := make([]string, 3) // each goroutine writes only its own index: no sharing, no race
+	for i := range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			greetings[i] = fmt.Sprintf("hello from goroutine %d", i)
+		}()
+	}
+	wg.Wait() // without this, main could return before any of them ran
+	fmt.Println("Results:", greetings)
+
+	// They're cheap: park 10,000 of them on a channel and count
+	before := runtime.NumGoroutine()
+	start := time.Now()
+	release := make(chan struct{})
+	for range 10_000 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-release
+		}()
+	}
+	fmt.Println("Started 10,000 goroutines in", time.Since(start).Round(time.Millisecond), "| Goroutines now:", runtime.NumGoroutine())
+	close(release) // one close wakes every receiver
+	wg.Wait()
+	// Wait only promises every Done ran; a goroutine can still be on its
+	// way out after its deferred Done, so give the count a moment to settle.
+	for deadline := time.Now().Add(100 * time.Millisecond); runtime.NumGoroutine() > before && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+	fmt.Println("After close(release) and Wait:", runtime.NumGoroutine(), "goroutines (was", before, "before the 10,000 started)")
+
+	// -- 2. Channels --
+	// Typed pipes between goroutines. Unbuffered = handoff, buffered = mailbox with a size.
+	fmt.Println("\n\033[1;36m=== 2. CHANNELS ===\033[0m")
+	unbuffered := make(chan string) // no buffer: a send waits until someone receives
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		unbuffered <- "ping"
+	}()
+	start = time.Now()
+	msg := <-unbuffered
+	fmt.Printf("Unbuffered: received %q after %v (the receiver waited for the sender)\n", msg, time.Since(start).Round(10*time.Millisecond))
+
+	buffered := make(chan int, 3) // room for 3 values: sends only block when it's full
+	buffered <- 1
+	buffered <- 2
+	buffered <- 3
+	fmt.Println("Buffered: sent 3 with nobody receiving | Len:", len(buffered), "| Cap:", cap(buffered))
+	select {
+	case buffered <- 4:
+		fmt.Println("  4th send went through?!")
+	default:
+		fmt.Println("  4th send would block: the buffer is full")
+	}
+
+	close(buffered) // closing says "no more values", the buffered ones can still be read
+	for v := range buffered {
+		fmt.Print("  Drained: ", v, "\n")
+	}
+	v, ok := <-buffered
+	fmt.Println("Receive after close and drain:", v, "| ok:", ok, "(zero value, never blocks)")
+
+	// Direction in the type documents who does what
+	producer := func(out chan<- int) { // send-only
+		for i := range 3 {
+			out <- i * i
+		}
+		close(out) // the sender closes, never the receiver
+	}
+	squares := make(chan int)
+	go producer(squares)
+	var got []int
+	for sq := range squares { // range stops when the channel is closed
+		got = append(got, sq)
+	}
+	fmt.Println("Producer with chan<- int, ranged until closed:", got)
+
+	// -- 3. Select --
+	// Wait on several channel operations at once; the first one ready wins.
+	fmt.Println("\n\033[1;36m=== 3. SELECT ===\033[0m")
+	fast, slow := make(chan string, 1), make(chan string, 1)
+	go func() { time.Sleep(10 * time.Millisecond); fast <- "fast" }()
+	go func() { time.Sleep(50 * time.Millisecond); slow <- "slow" }()
+	select {
+	case m := <-fast:
+		fmt.Println("First ready:", m)
+	case m := <-slow:
+		fmt.Println("First ready:", m)
+	}
+
+	select { // timeout: time.After is just a channel that receives once
+	case m := <-slow:
+		fmt.Println("Got", m)
+	case <-time.After(5 * time.Millisecond):
+		fmt.Println("Timeout: gave up on slow after 5ms (it's still coming, the buffer keeps its goroutine from leaking)")
+	}
+
+	select { // default makes any select non-blocking
+	case m := <-make(chan string):
+		fmt.Println("Got", m)
+	default:
+		fmt.Println("Non-blocking receive: nothing ready, took the default branch")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Millisecond)
+	defer cancel()
+	select { // the context version of a timeout, which also works across function calls
+	case <-time.After(time.Second):
+		fmt.Println("Finished the slow job")
+	case <-ctx.Done():
+		fmt.Println("Context deadline:", ctx.Err())
+	}
+
+	// -- 4. The sync Package --
+	// WaitGroup, Mutex, RWMutex and Once (see sync.go)
+	fmt.Println("\n\033[1;36m=== 4. SYNC: WAITGROUP, MUTEX, RWMUTEX, ONCE ===\033[0m")
+	syncSection()
+
+	// -- 5. Atomics --
+	// Lock-free updates of a single value (see sync.go)
+	fmt.Println("\n\033[1;36m=== 5. ATOMIC ===\033[0m")
+	atomicSection()
+
+	// -- 6. Fan-Out / Fan-In --
+	// errgroup-style: run tasks concurrently, stop them all at the first error (see patterns.go)
+	fmt.Println("\n\033[1;36m=== 6. FAN-OUT / FAN-IN ===\033[0m")
+	fanOutSection()
+
+	// -- 7. Worker Pools --
+	// A fixed number of goroutines sharing a queue of jobs (see patterns.go)
+	fmt.Println("\n\033[1;36m=== 7. WORKER POOLS ===\033[0m")
+	workerPoolSection()
+
+	// -- 8. Pipelines --
+	// Stages connected by channels, all torn down by one cancel (see patterns.go)
+	fmt.Println("\n\033[1;36m=== 8. PIPELINES WITH CANCELLATION ===\033[0m")
+	pipelineSection()
+
+	// -- 9. Data Races --
+	// What goes wrong without synchronization, and what the race detector says (see races.go)
+	fmt.Println("\n\033[1;36m=== 9. DATA RACES ===\033[0m")
+	racesSection(*runRaces)
+}
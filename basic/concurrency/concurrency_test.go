@@ -0,0 +1,59 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// raceCaseEnv tells a re-run of the test binary to run one racy snippet
+// and nothing else.
+const raceCaseEnv = "CONCURRENCY_RACE_CASE"
+
+// TestRacySnippetsAreCaught runs each racy snippet in a child copy of the
+// test binary and expects the race detector to report it and fail the
+// child. The race would otherwise fail this test too, so it has to happen
+// in another process. Run it with: go test -race
+func TestRacySnippetsAreCaught(t *testing.T) {
+	if env := os.Getenv(raceCaseEnv); env != "" {
+		i, err := strconv.Atoi(env)
+		if err != nil || i < 0 || i >= len(raceCases) {
+			t.Fatalf("%s=%q is not a race case", raceCaseEnv, env)
+		}
+		raceCases[i].racy()
+		return
+	}
+	if !raceDetector {
+		t.Skip("needs the race detector: go test -race")
+	}
+	for i, c := range raceCases {
+		t.Run(c.name, func(t *testing.T) {
+			cmd := exec.Command(os.Args[0], "-test.run=^TestRacySnippetsAreCaught$", "-test.count=1")
+			cmd.Env = append(os.Environ(), raceCaseEnv+"="+strconv.Itoa(i))
+			out, err := cmd.CombinedOutput()
+			if !strings.Contains(string(out), "WARNING: DATA RACE") {
+				t.Errorf("no DATA RACE report for %q:\n%s", c.name, out)
+			}
+			if err == nil {
+				t.Errorf("child test passed, want the race detector to fail it")
+			}
+		})
+	}
+}
+
+// TestFixedSnippets runs every fixed version and checks its answer. Under
+// go test -race it also proves the fixes are race-free, since any report
+// fails the test.
+func TestFixedSnippets(t *testing.T) {
+	for _, c := range raceCases {
+		t.Run(c.fixedBy, func(t *testing.T) {
+			for range 20 {
+				if got := c.fixed(); got != c.expected {
+					t.Fatalf("%s: got %d, want %d", c.name, got, c.expected)
+				}
+			}
+		})
+	}
+}
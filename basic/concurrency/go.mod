@@ -0,0 +1,3 @@
+module golang/concurrency
+
+go 1.25.7
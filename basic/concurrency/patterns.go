@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// group runs tasks in goroutines and returns the first error, cancelling
+// the others' context when it happens. It's the shape of
+// golang.org/x/sync/errgroup, written out with only the standard library.
+type group struct {
+	wg      sync.WaitGroup
+	errOnce sync.Once
+	err     error
+	cancel  context.CancelFunc
+}
+
+func withContext(ctx context.Context) (*group, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &group{cancel: cancel}, ctx
+}
+
+// Go starts task in its own goroutine. The first task to fail wins:
+// its error is kept and everyone else's context is cancelled.
+func (g *group) Go(task func() error) {
+	g.wg.Go(func() {
+		if err := task(); err != nil {
+			g.errOnce.Do(func() {
+				g.err = err
+				g.cancel()
+			})
+		}
+	})
+}
+
+// Wait blocks until every task has returned, then reports the first error.
+func (g *group) Wait() error {
+	g.wg.Wait()
+	g.cancel()
+	return g.err
+}
+
+// fetch pretends to download url. Like any well-behaved call it gives up
+// as soon as its context is cancelled.
+func fetch(ctx context.Context, url string, took time.Duration) (string, error) {
+	select {
+	case <-time.After(took):
+		if strings.Contains(url, "broken") {
+			return "", fmt.Errorf("fetch %s: 500 internal server error", url)
+		}
+		return fmt.Sprintf("%s (%d bytes)", url, len(url)*100), nil
+	case <-ctx.Done():
+		return "", fmt.Errorf("fetch %s: %w", url, ctx.Err())
+	}
+}
+
+// merge fans in: it forwards every value from every input channel to one
+// output, and closes the output once all inputs are closed.
+func merge[T any](ctx context.Context, inputs ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	for _, in := range inputs {
+		wg.Go(func() {
+			for v := range in {
+				select {
+				case out <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// fanOutSection runs downloads concurrently, once where they all succeed
+// and once where one failure cancels the rest, then merges several
+// producers into one channel.
+func fanOutSection() {
+	urls := []string{"go.dev", "pkg.go.dev", "gobyexample.com", "go.dev/blog"}
+	results := make([]string, len(urls)) // one slot per task: no lock needed
+	g, ctx := withContext(context.Background())
+	start := time.Now()
+	for i, url := range urls {
+		g.Go(func() error {
+			page, err := fetch(ctx, url, 20*time.Millisecond)
+			results[i] = page
+			return err
+		})
+	}
+	err := g.Wait()
+	fmt.Printf("Fan-out, all succeed: %d fetches of 20ms each took %v | err: %v\n", len(urls), time.Since(start).Round(5*time.Millisecond), err)
+	for _, r := range results {
+		fmt.Println("  ", r)
+	}
+
+	var cancelled sync.Map
+	g, ctx = withContext(context.Background())
+	start = time.Now()
+	for i, url := range append(urls, "broken.example") {
+		took := 200 * time.Millisecond
+		if url == "broken.example" {
+			took = 10 * time.Millisecond
+		}
+		g.Go(func() error {
+			_, err := fetch(ctx, url, took)
+			if errors.Is(err, context.Canceled) {
+				cancelled.Store(i, url)
+			}
+			return err
+		})
+	}
+	err = g.Wait()
+	n := 0
+	cancelled.Range(func(_, _ any) bool { n++; return true })
+	fmt.Printf("Fan-out, one fails: returned after %v instead of 200ms\n", time.Since(start).Round(5*time.Millisecond))
+	fmt.Println("   First error:", err)
+	fmt.Println("   Cancelled because of it:", n, "other fetches")
+
+	// Fan-in: three producers, one consumer
+	producer := func(name string, count int) <-chan string {
+		out := make(chan string)
+		go func() {
+			defer close(out)
+			for i := range count {
+				out <- fmt.Sprintf("%s-%d", name, i)
+			}
+		}()
+		return out
+	}
+	var merged []string
+	for v := range merge(context.Background(), producer("a", 2), producer("b", 3), producer("c", 1)) {
+		merged = append(merged, v)
+	}
+	sort.Strings(merged) // arrival order depends on scheduling
+	fmt.Println("Fan-in: merge(a, b, c) delivered", len(merged), "values:", merged)
+}
+
+// job and result are what flows through the worker pool.
+type job struct {
+	ID   int
+	Cost time.Duration
+}
+
+type result struct {
+	JobID  int
+	Worker int
+}
+
+// workerPoolSection runs 20 jobs on 4 workers: a bounded amount of
+// concurrency no matter how many jobs arrive.
+func workerPoolSection() {
+	const workers, jobCount = 4, 20
+	jobs := make(chan job)
+	results := make(chan result)
+
+	var wg sync.WaitGroup
+	for w := 1; w <= workers; w++ {
+		wg.Go(func() {
+			for j := range jobs { // each worker takes the next job whenever it's free
+				time.Sleep(j.Cost)
+				results <- result{JobID: j.ID, Worker: w}
+			}
+		})
+	}
+	go func() { // close results once every worker has exited, so the range below ends
+		wg.Wait()
+		close(results)
+	}()
+
+	var queue []job
+	var sequential time.Duration
+	for i := range jobCount {
+		queue = append(queue, job{ID: i, Cost: time.Duration(2+i%3) * time.Millisecond})
+		sequential += queue[i].Cost
+	}
+
+	start := time.Now()
+	go func() {
+		for _, j := range queue {
+			jobs <- j
+		}
+		close(jobs) // tells workers there's nothing left
+	}()
+
+	perWorker := map[int]int{}
+	done := 0
+	for r := range results {
+		perWorker[r.Worker]++
+		done++
+	}
+	elapsed := time.Since(start)
+	fmt.Printf("%d jobs on %d workers: %v (sequential would be about %v)\n", done, workers, elapsed.Round(time.Millisecond), sequential)
+	for w := 1; w <= workers; w++ {
+		fmt.Printf("  Worker %d handled %d jobs\n", w, perWorker[w])
+	}
+	fmt.Println("The pool size caps concurrency: 20 jobs never means 20 open connections or 20 files at once.")
+}
+
+// The pipeline stages each own their output channel, close it when
+// done, and stop early when ctx is cancelled so no goroutine leaks.
+
+func generate(ctx context.Context) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for n := 1; ; n++ { // infinite on purpose: only cancellation stops it
+			select {
+			case out <- n:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func square(ctx context.Context, in <-chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for n := range in {
+			select {
+			case out <- n * n:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func onlyOdd(ctx context.Context, in <-chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for n := range in {
+			if n%2 == 0 {
+				continue
+			}
+			select {
+			case out <- n:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// pipelineSection connects generate → square → onlyOdd, takes the first
+// few values and cancels, then checks the stages really exited.
+func pipelineSection() {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	stages := onlyOdd(ctx, square(ctx, generate(ctx)))
+	var taken []int
+	for v := range stages {
+		taken = append(taken, v)
+		if len(taken) == 5 {
+			break
+		}
+	}
+	fmt.Println("generate → square → onlyOdd, first 5:", taken)
+	fmt.Println("Goroutines while the pipeline is running:", runtime.NumGoroutine(), "(was", before, "before)")
+
+	cancel() // one call reaches every stage through the shared ctx
+	for range stages {
+		// drain until the last stage closes its channel: proof everything upstream returned
+	}
+	for deadline := time.Now().Add(100 * time.Millisecond); runtime.NumGoroutine() > before && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond) // upstream stages may still be returning
+	}
+	fmt.Println("After cancel():", runtime.NumGoroutine(), "goroutines: the stages exited instead of leaking")
+	fmt.Println("Without ctx, breaking out of the loop would leave all three stages blocked on a send forever.")
+}
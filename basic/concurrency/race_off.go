@@ -0,0 +1,6 @@
+//go:build !race
+
+package main
+
+// raceDetector reports whether the program was built with -race.
+const raceDetector = false
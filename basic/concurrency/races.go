@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
+// raceCase is one racy snippet next to its fixed version. Both return
+// what they computed so the output can show the damage.
+type raceCase struct {
+	name     string
+	racy     func() int
+	fixed    func() int
+	fixedBy  string
+	expected int
+}
+
+var raceCases = []raceCase{
+	{
+		name:     "counter++ from 50 goroutines × 1,000",
+		expected: 50_000,
+		racy: func() int {
+			n := 0
+			var wg sync.WaitGroup
+			for range 50 {
+				wg.Go(func() {
+					for range 1000 {
+						n++ // read, add, write: two goroutines can read the same old value
+					}
+				})
+			}
+			wg.Wait()
+			return n
+		},
+		fixedBy: "atomic.Int64",
+		fixed: func() int {
+			var n atomic.Int64
+			var wg sync.WaitGroup
+			for range 50 {
+				wg.Go(func() {
+					for range 1000 {
+						n.Add(1)
+					}
+				})
+			}
+			wg.Wait()
+			return int(n.Load())
+		},
+	},
+	{
+		name:     "append to a shared slice from 100 goroutines",
+		expected: 100,
+		racy: func() int {
+			var ids []int
+			var wg sync.WaitGroup
+			for i := range 100 {
+				wg.Go(func() {
+					ids = append(ids, i) // two appends can write the same slot, or grow from the same old header
+				})
+			}
+			wg.Wait()
+			return len(ids)
+		},
+		fixedBy: "sync.Mutex around append",
+		fixed: func() int {
+			var ids []int
+			var mu sync.Mutex
+			var wg sync.WaitGroup
+			for i := range 100 {
+				wg.Go(func() {
+					mu.Lock()
+					ids = append(ids, i)
+					mu.Unlock()
+				})
+			}
+			wg.Wait()
+			return len(ids)
+		},
+	},
+	{
+		name:     "check-then-act on a balance of 100, ten withdrawals of 30",
+		expected: 10, // 3 withdrawals succeed, balance 10
+		racy: func() int {
+			balance := 100
+			var wg sync.WaitGroup
+			for range 10 {
+				wg.Go(func() {
+					if balance >= 30 { // another goroutine can withdraw between this check...
+						balance -= 30 // ...and this write
+					}
+				})
+			}
+			wg.Wait()
+			return balance
+		},
+		fixedBy: "one Mutex held across check and write",
+		fixed: func() int {
+			balance := 100
+			var mu sync.Mutex
+			var wg sync.WaitGroup
+			for range 10 {
+				wg.Go(func() {
+					mu.Lock()
+					defer mu.Unlock()
+					if balance >= 30 {
+						balance -= 30
+					}
+				})
+			}
+			wg.Wait()
+			return balance
+		},
+	},
+}
+
+// racesSection runs each deliberately racy snippet and its fix. The racy
+// ones only run with -races, and they're most useful under the race
+// detector (go run -race . -races), which reports every one of them
+// even on runs where the numbers happen to come out right.
+func racesSection(run bool) {
+	fmt.Printf("  %-58s | %8s | %s\n", "Snippet", "Expected", "Got")
+	if !run {
+		for _, c := range raceCases {
+			fmt.Printf("  %-58s | %8d | \033[90m(skipped)\033[0m\n", c.name, c.expected)
+		}
+		fmt.Println("  \033[90m(skipped - run with -races; add -race to go run to see the detector catch them)\033[0m")
+		return
+	}
+
+	if raceDetector {
+		fmt.Println("  \033[1;33mBuilt with -race: expect WARNING: DATA RACE reports on stderr, and exit status 66 at the end.\033[0m")
+	} else {
+		fmt.Println("  \033[33mNot built with -race: the wrong answers below are luck. Try: go run -race . -races\033[0m")
+	}
+	for _, c := range raceCases {
+		got := c.racy()
+		verdict := "\033[32mcorrect this time\033[0m (still a race!)"
+		if got != c.expected {
+			verdict = "\033[31mwrong\033[0m"
+		}
+		fmt.Printf("  %-58s | %8d | %d %s\n", c.name, c.expected, got, verdict)
+		fmt.Printf("  \033[90m  fixed with %-47s\033[0m | %8d | %d\n", c.fixedBy, c.expected, c.fixed())
+		os.Stderr.Sync() // keep the detector's report next to the example that caused it
+	}
+	fmt.Println("A race is any unsynchronized access to the same memory where at least one is a write.")
+	fmt.Println("Its result can look right a thousand times; the race detector doesn't need the bug to show, only the access.")
+}
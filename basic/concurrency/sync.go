@@ -0,0 +1,191 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// counter is the textbook Mutex example: the lock guards n, and every
+// access to n goes through a method that holds it.
+type counter struct {
+	mu sync.Mutex
+	n  int
+}
+
+func (c *counter) Inc() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.n++
+}
+
+func (c *counter) Value() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.n
+}
+
+// cache is read far more often than it's written, which is what RWMutex
+// is for: any number of readers at once, or one writer alone.
+type cache struct {
+	mu    sync.RWMutex
+	items map[string]string
+}
+
+func (c *cache) Get(key string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	v, ok := c.items[key]
+	return v, ok
+}
+
+func (c *cache) Set(key, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = value
+}
+
+// syncSection shows the sync package's building blocks, each under
+// contention from many goroutines.
+func syncSection() {
+	fmt.Println("WaitGroup: Add before starting, Done when finished, Wait for all:")
+	var wg sync.WaitGroup
+	durations := make([]time.Duration, 4)
+	for i := range durations {
+		wg.Add(1) // before the go statement, or Wait might run first and see 0
+		go func() {
+			defer wg.Done()
+			durations[i] = time.Duration(i+1) * 5 * time.Millisecond
+			time.Sleep(durations[i])
+		}()
+	}
+	start := time.Now()
+	wg.Wait()
+	fmt.Printf("  4 sleeps of %v took %v together: they ran at the same time\n", durations, time.Since(start).Round(5*time.Millisecond))
+
+	var wg2 sync.WaitGroup
+	var finished atomic.Int32
+	for range 5 {
+		wg2.Go(func() { finished.Add(1) }) // Go 1.25: Add(1), go, defer Done() in one call
+	}
+	wg2.Wait()
+	fmt.Println("  wg.Go (Go 1.25+) ran", finished.Load(), "tasks with no Add/Done to forget")
+
+	fmt.Println("\nMutex: 100 goroutines × 1,000 increments on one counter:")
+	c := &counter{}
+	var wg3 sync.WaitGroup
+	for range 100 {
+		wg3.Go(func() {
+			for range 1000 {
+				c.Inc()
+			}
+		})
+	}
+	wg3.Wait()
+	fmt.Println("  Final count:", c.Value(), "(always 100000; see section 9 for what happens without the lock)")
+
+	fmt.Println("\nRWMutex: 20 readers and 2 writers on one cache:")
+	ch := &cache{items: map[string]string{"lang": "Go"}}
+	var hits, misses atomic.Int64
+	var wg4 sync.WaitGroup
+	for i := range 2 {
+		wg4.Go(func() {
+			for j := range 50 {
+				ch.Set(fmt.Sprintf("key%d-%d", i, j), "value")
+			}
+		})
+	}
+	for range 20 {
+		wg4.Go(func() {
+			for range 500 {
+				if _, ok := ch.Get("lang"); ok {
+					hits.Add(1)
+				} else {
+					misses.Add(1)
+				}
+			}
+		})
+	}
+	wg4.Wait()
+	fmt.Println("  Reads:", hits.Load()+misses.Load(), "| Hits:", hits.Load(), "| Keys after writes:", len(ch.items))
+	fmt.Println("  Readers share the RLock; a writer waits until they're out, then blocks new readers.")
+
+	fmt.Println("\nOnce: 10 goroutines all ask for the config, it loads exactly once:")
+	var once sync.Once
+	var loads atomic.Int32
+	var config map[string]string
+	loadConfig := func() {
+		loads.Add(1)
+		time.Sleep(5 * time.Millisecond) // pretend to read a file
+		config = map[string]string{"env": "prod"}
+	}
+	var wg5 sync.WaitGroup
+	for range 10 {
+		wg5.Go(func() {
+			once.Do(loadConfig) // the others block here until the first call returns
+			_ = config["env"]
+		})
+	}
+	wg5.Wait()
+	fmt.Println("  Loads:", loads.Load(), "| Config:", config)
+
+	portLookups := 0
+	getPort := sync.OnceValue(func() int { // Go 1.21: Once plus the value it computed
+		portLookups++
+		return 8080
+	})
+	ports := []int{getPort(), getPort(), getPort()}
+	fmt.Println("  sync.OnceValue: called 3 times →", ports, "| computed:", portLookups, "time")
+}
+
+// settings is swapped as a whole, never modified in place, so readers
+// can use an atomic pointer instead of a lock.
+type settings struct {
+	Version  int
+	Features []string
+}
+
+// atomicSection shows the typed atomics: counters, compare-and-swap, and
+// swapping a pointer to immutable data.
+func atomicSection() {
+	var requests atomic.Int64
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Go(func() {
+			for range 200 {
+				requests.Add(1) // one CPU instruction, no lock
+			}
+		})
+	}
+	wg.Wait()
+	fmt.Println("atomic.Int64: 50 goroutines × 200 Add(1) =", requests.Load())
+
+	// CompareAndSwap: only one of many goroutines wins the right to do something
+	var leader atomic.Int32 // 0 = nobody yet
+	var wins atomic.Int32
+	for id := int32(1); id <= 8; id++ {
+		wg.Go(func() {
+			if leader.CompareAndSwap(0, id) {
+				wins.Add(1)
+			}
+		})
+	}
+	wg.Wait()
+	fmt.Println("CompareAndSwap(0, id): 8 goroutines tried,", wins.Load(), "won | leader =", leader.Load())
+
+	// atomic.Pointer: readers always see a complete settings, old or new, never half of each
+	var current atomic.Pointer[settings]
+	current.Store(&settings{Version: 1, Features: []string{"search"}})
+	for range 4 {
+		wg.Go(func() {
+			s := current.Load()
+			_ = len(s.Features)
+		})
+	}
+	current.Store(&settings{Version: 2, Features: []string{"search", "export"}}) // swap, don't mutate
+	wg.Wait()
+	fmt.Printf("atomic.Pointer[settings]: hot-swapped to %+v\n", *current.Load())
+
+	fmt.Println("Use atomics for one counter or one pointer; as soon as two values must change together, use a Mutex.")
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ifSection shows the init statement, its scope, and the shadowing trap.
+func ifSection() {
+	scores := map[string]int{"ada": 92, "linus": 71}
+	for _, name := range []string{"ada", "linus", "bob"} {
+		if score, ok := scores[name]; !ok {
+			fmt.Printf("  %-6s → no score (ok is false)\n", name)
+		} else if score >= 90 {
+			fmt.Printf("  %-6s → %d, top marks\n", name, score)
+		} else {
+			fmt.Printf("  %-6s → %d (score and ok are visible in every else branch too)\n", name, score)
+		}
+	}
+	// score and ok don't exist here: the init statement keeps them out of the rest of the function
+
+	if n, err := strconv.Atoi("42"); err == nil {
+		fmt.Println("if n, err := strconv.Atoi(\"42\"); err == nil →", n)
+	}
+
+	err := errors.New("outer error")
+	if _, err := strconv.Atoi("7"); err == nil {
+		fmt.Println("Inside the if, err is a NEW variable:", err)
+	}
+	fmt.Println("\033[33mGotcha:\033[0m after the if, the outer err is untouched:", err)
+	fmt.Println("  := in an init statement always declares, even when a variable of that name exists outside.")
+	fmt.Println("\033[33mGotcha:\033[0m `}` and `else` must share a line; a newline after } ends the statement.")
+	fmt.Println("No ternary operator either: write the if/else, or a small function if you need it inline.")
+}
+
+// grade uses a switch with no tag: each case is a bool, first true wins.
+func grade(score int) string {
+	switch {
+	case score >= 90:
+		return "A"
+	case score >= 80:
+		return "B"
+	case score >= 70:
+		return "C"
+	default:
+		return "F"
+	}
+}
+
+// permissions uses fallthrough to make each level include the ones below.
+func permissions(level int) []string {
+	var perms []string
+	switch level {
+	case 3:
+		perms = append(perms, "admin")
+		fallthrough
+	case 2:
+		perms = append(perms, "write")
+		fallthrough
+	case 1:
+		perms = append(perms, "read")
+	}
+	return perms
+}
+
+// switchSection covers tagless switch, lists of values, init statements,
+// lazy case evaluation and fallthrough.
+func switchSection() {
+	fmt.Printf("Expression-less switch (switch { case score >= 90: ... }): 95→%s 85→%s 72→%s 40→%s\n", grade(95), grade(85), grade(72), grade(40))
+
+	for _, day := range []time.Weekday{time.Saturday, time.Wednesday} {
+		switch day {
+		case time.Saturday, time.Sunday: // a list of values, no need for fallthrough
+			fmt.Printf("switch day { case Saturday, Sunday: } → %s is a weekend\n", day)
+		default:
+			fmt.Printf("switch day { ... default: } → %s is a weekday\n", day)
+		}
+	}
+
+	for _, hour := range []int{9, 45} {
+		switch h := hour % 24; { // init statement, then no tag
+		case h < 12:
+			fmt.Printf("switch h := %d %% 24; { case h < 12: } → morning (h = %d)\n", hour, h)
+		default:
+			fmt.Printf("switch h := %d %% 24; { ... } → h = %d, so not morning\n", hour, h)
+		}
+	}
+
+	var checked []string
+	check := func(name string, result bool) bool {
+		checked = append(checked, name)
+		return result
+	}
+	switch {
+	case check("first", false):
+	case check("second", true):
+	case check("third", true):
+	}
+	fmt.Println("Cases are tried top to bottom and stop at the first match. Evaluated:", strings.Join(checked, ", "))
+
+	fmt.Println("fallthrough runs the next case's body too: permissions(3) =", permissions(3), "| permissions(1) =", permissions(1))
+
+	x := 5
+	var matched []string
+	switch {
+	case x > 0:
+		matched = append(matched, "x > 0")
+		fallthrough
+	case x > 100:
+		matched = append(matched, "x > 100")
+	}
+	fmt.Printf("\033[33mGotcha:\033[0m fallthrough doesn't check the next case. With x = %d, both bodies ran: %v\n", x, matched)
+	fmt.Println("  It must be the last statement in a case, can't be used in the final case, and isn't allowed in a type switch.")
+
+	var seen []int
+	for i := range 5 {
+		switch {
+		case i == 2:
+			break // leaves the switch, NOT the loop
+		}
+		seen = append(seen, i)
+	}
+	fmt.Println("\033[33mGotcha:\033[0m break inside a switch inside a loop only exits the switch. The loop saw:", seen)
+	fmt.Println("  To leave the loop, label it and `break Loop` (section 5).")
+	fmt.Println("Coming from C? No break needed at the end of a case: Go never falls through unless you say so.")
+}
+
+// temperature is a Stringer, to show case order in a type switch.
+type temperature float64
+
+func (t temperature) String() string { return strconv.FormatFloat(float64(t), 'f', 1, 64) + "°C" }
+
+// describe branches on the dynamic type held in v.
+func describe(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return "nil (no type at all)"
+	case int:
+		return fmt.Sprintf("int, doubled: %d", x*2) // x is an int here
+	case string:
+		return fmt.Sprintf("string of %d bytes: %q", len(x), x)
+	case []int:
+		return fmt.Sprintf("[]int with %d elements", len(x))
+	case int8, int16, int32:
+		return fmt.Sprintf("small int %v (x is an any here, holding an %T)", x, x)
+	case error:
+		return "error: " + x.Error()
+	case fmt.Stringer:
+		return "Stringer: " + x.String()
+	default:
+		return fmt.Sprintf("something else: %T", x)
+	}
+}
+
+// typeSwitchSection runs describe over one value of each kind.
+func typeSwitchSection() {
+	values := []any{42, "gopher", []int{1, 2, 3}, int16(7), errors.New("boom"), temperature(21.5), 3.14, nil}
+	for _, v := range values {
+		fmt.Printf("  describe(%-10s) → %s\n", fmt.Sprint(v), describe(v))
+	}
+	fmt.Println("In a single-type case, x has that type. In a list (case int8, int16, int32) or default, x keeps the interface type.")
+	fmt.Println("\033[33mGotcha:\033[0m order matters: the first matching case wins. Put error before fmt.Stringer,")
+	fmt.Println("  or a type that is both will always land in the Stringer case.")
+	fmt.Println("Section 4 of the interfaces guide covers type assertions and the comma-ok form.")
+}
@@ -0,0 +1,45 @@
+package main
+
+import "fmt"
+
+func main() {
+	// -- 1. if with an Init Statement --
+	// if v, err := f(); err != nil { ... }: the variables live only as long as the if/else chain (see branches.go)
+	fmt.Println("\n\033[1;36m=== 1. IF WITH INIT ===\033[0m")
+	ifSection()
+
+	// -- 2. The Four for Loops --
+	// Go has one loop keyword and four shapes of it (see loops.go)
+	fmt.Println("\n\033[1;36m=== 2. FOR LOOPS ===\033[0m")
+	forSection()
+
+	// -- 3. switch --
+	// No fallthrough by default, cases can be any expression, and the tag is optional (see branches.go)
+	fmt.Println("\n\033[1;36m=== 3. SWITCH ===\033[0m")
+	switchSection()
+
+	// -- 4. Type Switch --
+	// switch v := x.(type): branch on the dynamic type of an interface (see branches.go)
+	fmt.Println("\n\033[1;36m=== 4. TYPE SWITCH ===\033[0m")
+	typeSwitchSection()
+
+	// -- 5. Labels and goto --
+	// break and continue an outer loop by name; goto for the rare jump forward (see loops.go)
+	fmt.Println("\n\033[1;36m=== 5. LABELS AND GOTO ===\033[0m")
+	labelsSection()
+
+	// -- 6. range over Integers, Strings and Channels --
+	// range n (Go 1.22), runes not bytes, and receive-until-closed (see ranges.go)
+	fmt.Println("\n\033[1;36m=== 6. RANGE OVER INT, STRING, CHANNEL ===\033[0m")
+	rangeSection()
+
+	// -- 7. range over Functions --
+	// Go 1.23 iterators: func(yield func(T) bool), iter.Seq and iter.Pull (see iterators.go)
+	fmt.Println("\n\033[1;36m=== 7. RANGE OVER FUNCTIONS ===\033[0m")
+	iteratorsSection()
+
+	// -- 8. range Gotchas --
+	// Copies, one-time evaluation and maps that change underneath you (see ranges.go)
+	fmt.Println("\n\033[1;36m=== 8. RANGE GOTCHAS ===\033[0m")
+	rangeGotchasSection()
+}
@@ -0,0 +1,3 @@
+module golang/controlflow
+
+go 1.25.7
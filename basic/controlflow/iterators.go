@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// countdown is a range-over-func iterator written out by hand: the loop
+// body becomes yield, and yield returns false when the loop breaks.
+func countdown(from int) func(yield func(int) bool) {
+	return func(yield func(int) bool) {
+		for i := from; i > 0; i-- {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// Fibonacci never ends on its own; the caller decides when to stop.
+func Fibonacci() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		a, b := 0, 1
+		for {
+			if !yield(a) {
+				return
+			}
+			a, b = b, a+b
+		}
+	}
+}
+
+// Filter and Take wrap one sequence in another, lazily.
+func Filter[T any](seq iter.Seq[T], keep func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if keep(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			if taken++; taken == n {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate is an iter.Seq2: two loop variables, like range over a slice.
+func Enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range seq {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// lines pretends to read from a file, and logs its cleanup so the early
+// break is visible.
+func lines(text string, log *[]string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		*log = append(*log, "open")
+		defer func() { *log = append(*log, "close") }() // runs even when the loop breaks
+		for line := range strings.Lines(text) {
+			if !yield(strings.TrimSpace(line)) {
+				return
+			}
+		}
+	}
+}
+
+// badIterator ignores yield's result, which the runtime refuses to allow.
+func badIterator(yield func(int) bool) {
+	yield(1)
+	yield(2) // called again after the loop body said stop
+}
+
+// iteratorsSection shows writing, combining, pulling and misusing iterators.
+func iteratorsSection() {
+	fmt.Print("for n := range countdown(3):")
+	for n := range countdown(3) {
+		fmt.Print(" ", n)
+	}
+	fmt.Println(" liftoff")
+
+	fmt.Print("Fibonacci() is infinite; break after 100:")
+	for f := range Fibonacci() {
+		if f > 100 {
+			break // yield returns false, the iterator returns
+		}
+		fmt.Print(" ", f)
+	}
+	fmt.Println()
+
+	evens := Take(Filter(Fibonacci(), func(n int) bool { return n%2 == 0 }), 5)
+	fmt.Println("Take(Filter(Fibonacci(), even), 5):", slices.Collect(evens), "(lazy: only computes what's asked for)")
+	for i, f := range Enumerate(Take(Fibonacci(), 3)) {
+		fmt.Printf("  Enumerate gives an iter.Seq2: %d → %d\n", i, f)
+	}
+
+	fmt.Println("The standard library speaks iterators too:")
+	langs := []string{"go", "rust", "zig"}
+	fmt.Print("  slices.Backward(langs):")
+	for i, l := range slices.Backward(langs) {
+		fmt.Printf(" %d:%s", i, l)
+	}
+	fmt.Println()
+	ages := map[string]int{"linus": 21, "ada": 36, "grace": 45}
+	fmt.Println("  slices.Sorted(maps.Keys(ages)):", slices.Sorted(maps.Keys(ages)))
+	fmt.Print("  strings.SplitSeq(\"a,b,c\", \",\"):")
+	for part := range strings.SplitSeq("a,b,c", ",") {
+		fmt.Print(" ", part)
+	}
+	fmt.Println(" (no []string allocated)")
+
+	var log []string
+	for line := range lines("first\nSTOP\nnever read\n", &log) {
+		if line == "STOP" {
+			break
+		}
+		log = append(log, "read "+line)
+	}
+	fmt.Println("Breaking out early still runs the iterator's defer:", strings.Join(log, " → "))
+
+	next, stop := iter.Pull(Fibonacci())
+	defer stop()
+	var pulled []int
+	for range 4 {
+		if v, ok := next(); ok {
+			pulled = append(pulled, v)
+		}
+	}
+	fmt.Println("iter.Pull turns a push iterator into next(): four calls gave", pulled, "- handy to walk two sequences in step")
+
+	func() {
+		defer func() { fmt.Println("\033[33mGotcha:\033[0m an iterator that ignores yield's false:", recover()) }()
+		for v := range badIterator {
+			_ = v
+			break
+		}
+	}()
+	fmt.Println("  Always check yield's result and return. The runtime panics rather than run a body that already broke out.")
+	fmt.Println("\033[33mGotcha:\033[0m an iter.Seq is a function, so each range runs it again from the start.")
+	fmt.Println("  Some, like one reading a network stream, can't restart: document whether yours is single-use.")
+}
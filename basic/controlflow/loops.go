@@ -0,0 +1,128 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// forSection shows every shape of for apart from range, which gets
+// sections of its own.
+func forSection() {
+	fmt.Print("Three-clause, for i := 0; i < 5; i++:  ")
+	for i := 0; i < 5; i++ {
+		if i == 1 {
+			continue // skip to the post statement (i++)
+		}
+		fmt.Print(i, " ")
+	}
+	fmt.Println("(continue skipped 1)")
+
+	n, steps := 27, 0
+	for n != 1 { // condition only: Go's while loop
+		if n%2 == 0 {
+			n /= 2
+		} else {
+			n = 3*n + 1
+		}
+		steps++
+	}
+	fmt.Println("Condition only, for n != 1 { ... }: Collatz(27) reaches 1 after", steps, "steps")
+
+	tries := 0
+	for { // no condition at all: runs until break or return
+		tries++
+		if tries*tries > 50 {
+			break
+		}
+	}
+	fmt.Println("Infinite, for { ... break }: first square over 50 is", tries, "squared")
+
+	word := []rune("stressed")
+	for i, j := 0, len(word)-1; i < j; i, j = i+1, j-1 { // parallel assignment, no comma operator
+		word[i], word[j] = word[j], word[i]
+	}
+	fmt.Println("Two variables, for i, j := 0, len-1; i < j; i, j = i+1, j-1: \"stressed\" reversed is", string(word))
+
+	fmt.Println("for range: see sections 6 to 8.")
+	fmt.Println("\033[33mGotcha:\033[0m no parentheses around the clauses, and the braces are mandatory, even for one line.")
+	fmt.Println("\033[33mGotcha:\033[0m since Go 1.22 each iteration gets a fresh i, so closures capture the value they")
+	fmt.Println("  saw. Older code copied it with i := i (the functions guide shows both behaviours).")
+}
+
+// flaky fails until its third call, to give goto something to retry.
+func flaky(calls *int) error {
+	*calls++
+	if *calls < 3 {
+		return errors.New("temporarily unavailable")
+	}
+	return nil
+}
+
+// labelsSection shows labeled break and continue, a labeled break out of
+// select, and goto.
+func labelsSection() {
+	grid := [][]int{
+		{3, 8, 1},
+		{9, 5, 7},
+		{4, 5, 2},
+	}
+	target, found, visited := 5, "", 0
+Search:
+	for r, row := range grid {
+		for c, v := range row {
+			visited++
+			if v == target {
+				found = fmt.Sprintf("row %d, column %d", r, c)
+				break Search // leaves both loops
+			}
+		}
+	}
+	fmt.Printf("break Search: first %d at %s after visiting %d of 9 cells\n", target, found, visited)
+
+	readings := [][]int{{20, 21, 22}, {19, -999, 20}, {23, 24, 22}}
+	var averages []int
+Rows:
+	for _, row := range readings {
+		sum := 0
+		for _, v := range row {
+			if v < -100 {
+				continue Rows // a sensor glitch: skip the whole row, not just this value
+			}
+			sum += v
+		}
+		averages = append(averages, sum/len(row))
+	}
+	fmt.Println("continue Rows: averages of the rows without glitches:", averages)
+
+	events := make(chan string, 3)
+	events <- "start"
+	events <- "tick"
+	close(events)
+	var got []string
+Loop:
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				break Loop // a plain break would only leave the select
+			}
+			got = append(got, e)
+		case <-time.After(time.Second):
+			break Loop
+		}
+	}
+	fmt.Println("break Loop out of for { select { ... } }, the most common real use of labels:", got)
+
+	calls := 0
+retry:
+	if err := flaky(&calls); err != nil {
+		fmt.Printf("goto: call %d failed (%v), retrying\n", calls, err)
+		goto retry
+	}
+	fmt.Println("goto: succeeded on call", calls)
+
+	fmt.Println("\033[33mGotcha:\033[0m a label has to be used, or it's a compile error: label Search defined and not used.")
+	fmt.Println("\033[33mGotcha:\033[0m goto can't jump into a block or over a variable declaration, so it stays local and")
+	fmt.Println("  harmless. A plain for loop is still clearer for retries; goto shows up mostly in generated code.")
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+	"unicode/utf8"
+)
+
+// rangeSection covers range over integers, strings and channels.
+func rangeSection() {
+	fmt.Printf("\033[1;33m▶ RANGE OVER AN INTEGER (Go 1.22)\033[0m\n")
+	fmt.Print("for i := range 5: ")
+	for i := range 5 {
+		fmt.Print(i, " ")
+	}
+	fmt.Println("(0 to n-1, like for i := 0; i < 5; i++)")
+
+	hits := 0
+	for range 3 { // no variable needed when you only want the repetition
+		hits++
+	}
+	fmt.Println("for range 3 { hits++ } → hits =", hits)
+
+	for i := range uint8(2) {
+		fmt.Printf("for i := range uint8(2): i is a %T (%d); the variable takes n's type\n", i, i)
+	}
+	zero, negative := 0, -3
+	for range zero {
+		fmt.Println("never printed")
+	}
+	for range negative {
+		fmt.Println("never printed")
+	}
+	fmt.Println("range 0 and range -3 run zero times, no panic")
+
+	fmt.Printf("\n\033[1;33m▶ RANGE OVER A STRING (runes, not bytes)\033[0m\n")
+	s := "héllo, 世界"
+	fmt.Printf("s = %q: len(s) = %d bytes, utf8.RuneCountInString(s) = %d runes\n", s, len(s), utf8.RuneCountInString(s))
+	fmt.Print("  for i, r := range s:")
+	for i, r := range s {
+		fmt.Printf(" %d:%c", i, r)
+	}
+	fmt.Println()
+	fmt.Println("  i is the BYTE offset where each rune starts, so it jumps: é and 世 take 2 and 3 bytes.")
+	fmt.Printf("  s[1] is a byte, not a character: %d (%q)\n", s[1], s[1])
+
+	bad := "a\xffb"
+	fmt.Print("Invalid UTF-8, range \"a\\xffb\":")
+	for i, r := range bad {
+		fmt.Printf(" %d:%U", i, r)
+	}
+	fmt.Println(" → the bad byte becomes U+FFFD (utf8.RuneError), 1 byte wide")
+	fmt.Println("\033[33mGotcha:\033[0m need the 3rd character? []rune(s)[2], not s[2]. For what users see as one")
+	fmt.Println("  character (é written as e + accent, emoji with skin tones), see the operations guide's utf8.go.")
+
+	fmt.Printf("\n\033[1;33m▶ RANGE OVER A CHANNEL (until it's closed)\033[0m\n")
+	squares := make(chan int)
+	go func() {
+		defer close(squares) // without this the range below never ends
+		for i := range 5 {
+			squares <- i * i
+		}
+	}()
+	fmt.Print("for v := range squares:")
+	for v := range squares {
+		fmt.Print(" ", v)
+	}
+	fmt.Println(" (loop ended because the sender closed the channel)")
+
+	never := make(chan int)
+	finished := make(chan struct{})
+	go func() {
+		for range never {
+		}
+		close(finished)
+	}()
+	never <- 1
+	select {
+	case <-finished:
+		fmt.Println("the range finished")
+	case <-time.After(50 * time.Millisecond):
+		fmt.Println("\033[33mGotcha:\033[0m nobody closed `never`, so its range is still waiting after 50ms: a leaked goroutine.")
+		fmt.Println("  In main it would be fatal: all goroutines are asleep - deadlock!")
+	}
+	close(never) // let it go
+	<-finished
+
+	fmt.Println("Channels give one value per iteration: for v := range ch, never for i, v := range ch.")
+	fmt.Println("Only the sender should close; ranging over a nil channel blocks forever.")
+}
+
+// item is a small struct for the copy gotchas.
+type item struct {
+	name  string
+	price int
+}
+
+// rangeGotchasSection shows what range copies and when it evaluates.
+func rangeGotchasSection() {
+	nums := []int{1, 2, 3}
+	for _, v := range nums {
+		nums = append(nums, v*10)
+	}
+	fmt.Println("Appending inside range nums:", nums, "→ 3 iterations: the range expression is evaluated once")
+
+	items := []item{{"pen", 2}, {"book", 12}}
+	for _, it := range items {
+		it.price *= 2 // changes the copy
+	}
+	fmt.Printf("\033[33mGotcha:\033[0m for _, it := range items { it.price *= 2 } → %v, unchanged: it is a copy\n", items)
+	for i := range items {
+		items[i].price *= 2
+	}
+	fmt.Printf("  for i := range items { items[i].price *= 2 } → %v\n", items)
+
+	arr := [3]int{1, 2, 3}
+	var seenArr []int
+	for i, v := range arr {
+		if i == 0 {
+			arr[2] = 100
+		}
+		seenArr = append(seenArr, v)
+	}
+	fmt.Printf("\033[33mGotcha:\033[0m ranging over an ARRAY copies it first: set arr[2] = 100 mid-loop, the loop saw %v\n", seenArr)
+	fmt.Println("  range over arr[:] or &arr to see the changes (and skip the copy of a big array).")
+
+	stock := map[string]int{"apple": 5, "pear": 0, "plum": 3, "fig": 0}
+	for name, n := range stock {
+		if n == 0 {
+			delete(stock, name) // deleting during range is safe
+		}
+	}
+	fmt.Println("Deleting inside a map range is allowed: what's left is", slices.Sorted(maps.Keys(stock)))
+	fmt.Println("\033[33mGotcha:\033[0m map order is random on purpose, and keys added during the range may or may not")
+	fmt.Println("  show up. Sort the keys (slices.Sorted(maps.Keys(m))) when order matters.")
+}
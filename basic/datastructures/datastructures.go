@@ -1,11 +1,18 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"unsafe"
+
+	"golang/datastructures/objgraph"
 )
 
 func main() {
+	graphFile := flag.String("graph", "", "also save the object graph to this file (.dot for Graphviz, .mmd or .md for Mermaid)")
+	runBench := flag.Bool("bench", false, "measure copy cost vs pointer passing and find the crossover (takes a few seconds)")
+	flag.Parse()
+
 	// -- 1. Arrays --
 	// Fixed size, homogeneous data structure. Size is part of type definition.
 	fmt.Println("\n\033[1;36m=== 1. ARRAYS ===\033[0m")
@@ -117,4 +124,30 @@ func main() {
 	fmt.Println("  Pointer to Field:", personPtr.Name)
 	personPtr.Name = "Jane Doe"
 	fmt.Println("  After Update via Pointer:", filledPerson.Name)
+
+	// -- 6. Nil Safety --
+	// Every nil operation actually run, with panics recovered (see nilsafety.go)
+	fmt.Println("\n\033[1;36m=== 6. NIL SAFETY ===\033[0m")
+	nilSafetySection()
+
+	// -- 7. Object Graph --
+	// Who points at what. personPtr doesn't hold a copy: it leads to filledPerson itself (see graph.go)
+	fmt.Println("\n\033[1;36m=== 7. OBJECT GRAPH ===\033[0m")
+	team := []*Person{personPtr, &emptyPerson}         // slice of pointers: no copies either
+	directory := map[string]*Person{"jane": personPtr} // a third way to reach the same Person
+	ring := &ListNode{Value: 1}
+	ring.Next = &ListNode{Value: 2, Next: ring} // a cycle: 1 → 2 → 1 → ...
+	graph := objgraph.New().
+		Var("filledPerson", &filledPerson).
+		Var("personPtr", &personPtr).
+		Var("emptyPerson", &emptyPerson).
+		Var("team", &team).
+		Var("directory", &directory).
+		Var("ring", &ring)
+	objectGraphSection(graph, *graphFile)
+
+	// -- 8. Values vs References --
+	// Everything is passed by value; the question is what the value contains (see semantics.go)
+	fmt.Println("\n\033[1;36m=== 8. VALUES VS REFERENCES ===\033[0m")
+	valueSemanticsSection(*runBench)
 }
@@ -0,0 +1,392 @@
+// Command escape asks the compiler where values live. It builds a package
+// with -gcflags=-m=2, reads the escape analysis and inlining diagnostics,
+// and prints them next to the source lines they talk about: which variables
+// were moved to the heap, which stayed on the stack, and why.
+//
+// Usage:
+//
+//	go run ./escape                      (the datastructures guide itself)
+//	go run ./escape -why ../operations   (any package directory)
+//	go run ./escape -inline -file nilsafety.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// category groups the compiler's many messages into the few that matter.
+type category int
+
+const (
+	heap      category = iota // moved to heap, escapes to heap
+	leak                      // a parameter flows somewhere that outlives the call
+	stack                     // does not escape
+	inlined                   // can inline, inlining call to
+	notInline                 // cannot inline
+	other                     // closure captures and everything else
+)
+
+var categoryStyles = map[category]struct{ color, label string }{
+	heap:      {"\033[31m", "heap"},
+	leak:      {"\033[33m", "leak"},
+	stack:     {"\033[32m", "stack"},
+	inlined:   {"\033[36m", "inline"},
+	notInline: {"\033[90m", "no inline"},
+	other:     {"\033[90m", "note"},
+}
+
+// diagnostic is one compiler message about one position in the source.
+type diagnostic struct {
+	file      string
+	line, col int
+	msg       string
+	cat       category
+	why       []string // the -m=2 "flow:" explanation, if the compiler gave one
+}
+
+// diagLine matches "./file.go:12:5: message". Messages that start with
+// spaces are explanation lines belonging to the message above them.
+var diagLine = regexp.MustCompile(`^(.+\.go):(\d+):(\d+): (.*)$`)
+
+func main() {
+	showInline := flag.Bool("inline", false, "also show inlining decisions")
+	showWhy := flag.Bool("why", false, "show the compiler's full explanation for each heap escape")
+	onlyFile := flag.String("file", "", "only annotate this file (e.g. datastructures.go)")
+	context := flag.Int("context", 0, "lines of unannotated source to show around each annotated line")
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	output, err := compile(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "\033[1;31m✗ %v\033[0m\n%s", err, output)
+		os.Exit(1)
+	}
+	// -file narrows everything below, the summary included.
+	var diags []diagnostic
+	for _, d := range parse(output) {
+		if *onlyFile == "" || filepath.Base(d.file) == *onlyFile {
+			diags = append(diags, d)
+		}
+	}
+
+	fmt.Printf("\n\033[1;36m╔════════════════════════════════════════════════════════════════╗\033[0m\n")
+	fmt.Printf("\033[1;36m║            ESCAPE ANALYSIS: STACK OR HEAP? (-m=2)              ║\033[0m\n")
+	fmt.Printf("\033[1;36m╚════════════════════════════════════════════════════════════════╝\033[0m\n")
+	fmt.Printf("Package: %s\n", dir)
+
+	byFile := map[string][]diagnostic{}
+	for _, d := range diags {
+		if !*showInline && (d.cat == inlined || d.cat == notInline) || d.cat == other {
+			continue
+		}
+		byFile[d.file] = append(byFile[d.file], d)
+	}
+	files := make([]string, 0, len(byFile))
+	for f := range byFile {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+
+	for _, f := range files {
+		if err := annotate(filepath.Join(dir, f), byFile[f], *showWhy, *context); err != nil {
+			fmt.Fprintf(os.Stderr, "\033[1;31m✗ %v\033[0m\n", err)
+		}
+	}
+	summarize(diags)
+}
+
+// compile runs the build and returns the compiler's diagnostics. The
+// binary is thrown away; only what the compiler said matters. When the
+// package is already cached, go build replays the saved diagnostics.
+func compile(dir string) ([]byte, error) {
+	cmd := exec.Command("go", "build", "-gcflags=-m=2", "-o", os.DevNull, ".")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return out, fmt.Errorf("go build in %s failed: %w", dir, err)
+	}
+	return out, nil
+}
+
+// parse turns compiler output into diagnostics, attaching each -m=2
+// explanation to the position it explains and dropping duplicates.
+func parse(output []byte) []diagnostic {
+	var diags []diagnostic
+	seen := map[string]bool{}
+	explanations := map[string][]string{} // "file:line:col" → explanation lines
+	var explaining string                 // position of the explanation being read
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		m := diagLine.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue // "# package" headers and <autogenerated> positions
+		}
+		pos := m[1] + ":" + m[2] + ":" + m[3]
+		msg := m[4]
+
+		switch {
+		case strings.HasPrefix(msg, " "):
+			explanations[explaining] = append(explanations[explaining], strings.TrimSpace(msg))
+			continue
+		case strings.HasSuffix(msg, ":"):
+			// "x escapes to heap in main:" starts an explanation; the
+			// short "moved to heap: x" summary is reported separately.
+			explaining = pos
+			explanations[pos] = append(explanations[pos], msg)
+			continue
+		}
+
+		if seen[pos+msg] {
+			continue
+		}
+		seen[pos+msg] = true
+		line, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		diags = append(diags, diagnostic{file: m[1], line: line, col: col, msg: msg, cat: classify(msg)})
+	}
+
+	for i := range diags {
+		if diags[i].cat == heap {
+			diags[i].why = explanations[fmt.Sprintf("%s:%d:%d", diags[i].file, diags[i].line, diags[i].col)]
+		}
+	}
+	return diags
+}
+
+func classify(msg string) category {
+	switch {
+	case strings.HasPrefix(msg, "moved to heap"), strings.Contains(msg, "escapes to heap"):
+		return heap
+	case strings.HasPrefix(msg, "leaking param"):
+		return leak
+	case strings.Contains(msg, "does not escape"):
+		return stack
+	case strings.HasPrefix(msg, "can inline"), strings.HasPrefix(msg, "inlining call to"):
+		return inlined
+	case strings.HasPrefix(msg, "cannot inline"):
+		return notInline
+	}
+	return other
+}
+
+// annotate prints each annotated line of path with its diagnostics under
+// it, a caret marking the exact column the compiler pointed at.
+func annotate(path string, diags []diagnostic, showWhy bool, context int) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	lines := strings.Split(string(src), "\n")
+
+	byLine := map[int][]diagnostic{}
+	for _, d := range diags {
+		byLine[d.line] = append(byLine[d.line], d)
+	}
+	show := map[int]bool{}
+	for n := range byLine {
+		for c := n - context; c <= n+context; c++ {
+			show[c] = true
+		}
+	}
+
+	fmt.Printf("\n\033[1;33m▶ %s\033[0m\n", filepath.Base(path))
+	fmt.Printf("\033[90m%s\033[0m\n", strings.Repeat("─", 77))
+	last := 0
+	for n := 1; n <= len(lines); n++ {
+		if !show[n] {
+			continue
+		}
+		if last != 0 && n != last+1 {
+			fmt.Println("\033[90m     ┆\033[0m")
+		}
+		last = n
+		text := expandTabs(lines[n-1])
+		fmt.Printf("\033[90m%4d │\033[0m %s\n", n, text)
+
+		for _, d := range collapse(byLine[n], showWhy) {
+			style := categoryStyles[d.cat]
+			caret := strings.Repeat(" ", displayColumn(lines[n-1], d.col))
+			fmt.Printf("\033[90m     │\033[0m %s%s^ %-9s\033[0m %s\n", caret, style.color, style.label, d.msg)
+			for _, w := range explain(d, showWhy) {
+				fmt.Printf("\033[90m     │ %s    %s\033[0m\n", caret, w)
+			}
+		}
+	}
+	return nil
+}
+
+// collapse sorts one line's diagnostics by column and merges heap escapes
+// that happen for the same reason. A fmt.Println with eight arguments
+// boxes all eight into interfaces, and one annotation says that better
+// than eight identical ones.
+func collapse(ds []diagnostic, showWhy bool) []diagnostic {
+	sort.SliceStable(ds, func(i, j int) bool { return ds[i].col < ds[j].col })
+	if showWhy {
+		return ds // the full explanations differ, so keep every one
+	}
+
+	var out []diagnostic
+	merged := map[string]int{} // reason → index in out
+	names := map[string][]string{}
+	for _, d := range ds {
+		reason := strings.Join(explain(d, false), "")
+		subject, isEscape := strings.CutSuffix(d.msg, " escapes to heap")
+		if d.cat != heap || !isEscape || reason == "" {
+			out = append(out, d)
+			continue
+		}
+		names[reason] = append(names[reason], subject)
+		if _, ok := merged[reason]; !ok {
+			merged[reason] = len(out)
+			out = append(out, d)
+		}
+	}
+	for reason, i := range merged {
+		if list := names[reason]; len(list) > 1 {
+			summary := strings.Join(list, ", ")
+			if len(summary) > 60 {
+				summary = summary[:57] + "..."
+			}
+			out[i].msg = fmt.Sprintf("%d values escape to heap: %s", len(list), summary)
+		}
+	}
+	return out
+}
+
+// explain returns the reason a value escaped: by default just the step
+// that sent it to the heap, or with -why the compiler's whole flow.
+func explain(d diagnostic, all bool) []string {
+	if len(d.why) == 0 {
+		return nil
+	}
+	if all {
+		return d.why[1:] // the first line repeats the message
+	}
+	// The flow into {heap} ends with the operation that finally made the
+	// value outlive its function; earlier flows are just the way there.
+	var reason string
+	inHeapFlow := false
+	for _, w := range d.why[1:] {
+		if strings.HasPrefix(w, "flow:") {
+			if inHeapFlow && reason != "" {
+				break
+			}
+			inHeapFlow = strings.HasPrefix(w, "flow: {heap}")
+			continue
+		}
+		if inHeapFlow && strings.HasPrefix(w, "from ") {
+			reason = w
+		}
+	}
+	if reason == "" {
+		return nil
+	}
+	return []string{"because: " + describeStep(reason)}
+}
+
+// flowStep matches "from <expr> (<kind>) at <file>:<line>:<col>".
+var flowStep = regexp.MustCompile(`^from (.*) \(([^()]+)\) at (?:\./)?(.+\.go:\d+):\d+$`)
+
+// describeStep rewrites one step of the compiler's flow in plainer words,
+// shortening call expressions to the name of the function being called.
+func describeStep(step string) string {
+	m := flowStep.FindStringSubmatch(step)
+	if m == nil {
+		return strings.TrimPrefix(step, "from ")
+	}
+	expr, kind, pos := m[1], m[2], m[3]
+	switch kind {
+	case "call parameter":
+		return fmt.Sprintf("passed to %s() at %s", callee(expr), pos)
+	case "return":
+		return fmt.Sprintf("returned (%s) at %s", expr, pos)
+	}
+	if len(expr) > 60 {
+		expr = expr[:57] + "..."
+	}
+	return fmt.Sprintf("%s (%s) at %s", expr, kind, pos)
+}
+
+// callee strips the argument list from a call: "(*T).M(a, f(b))" → "(*T).M".
+func callee(call string) string {
+	if !strings.HasSuffix(call, ")") {
+		return call
+	}
+	depth := 0
+	for i := len(call) - 1; i >= 0; i-- {
+		switch call[i] {
+		case ')':
+			depth++
+		case '(':
+			depth--
+			if depth == 0 {
+				return call[:i]
+			}
+		}
+	}
+	return call
+}
+
+// expandTabs and displayColumn agree on a tab width of 4, so carets
+// line up under the code they point at.
+func expandTabs(s string) string {
+	return strings.ReplaceAll(s, "\t", "    ")
+}
+
+func displayColumn(line string, col int) int {
+	width := 0
+	for i, r := range line {
+		if i >= col-1 {
+			break
+		}
+		if r == '\t' {
+			width += 4
+		} else {
+			width++
+		}
+	}
+	return width
+}
+
+// summarize counts diagnostics by category and lists the variables that
+// were moved to the heap, which is usually the interesting part. It sees
+// the same files as the annotations above it.
+func summarize(diags []diagnostic) {
+	counts := map[category]int{}
+	var moved []string
+	for _, d := range diags {
+		counts[d.cat]++
+		if name, ok := strings.CutPrefix(d.msg, "moved to heap: "); ok {
+			moved = append(moved, fmt.Sprintf("%s (%s:%d)", name, filepath.Base(d.file), d.line))
+		}
+	}
+
+	fmt.Printf("\n\033[1;32m▶ SUMMARY\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", strings.Repeat("─", 77))
+	for _, c := range []category{heap, leak, stack, inlined, notInline} {
+		style := categoryStyles[c]
+		fmt.Printf("%s%-10s\033[0m %5d\n", style.color, style.label, counts[c])
+	}
+	if len(moved) > 0 {
+		fmt.Printf("Variables moved to the heap: %s\n", strings.Join(moved, ", "))
+	} else {
+		fmt.Println("No variables were moved to the heap.")
+	}
+	fmt.Println()
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang/datastructures/objgraph"
+)
+
+// objectGraphSection prints the memory graph behind the guide's variables
+// and, when a file name was given with -graph, also writes it as Graphviz
+// DOT (.dot, .gv) or Mermaid (.mmd, .md).
+func objectGraphSection(g *objgraph.Graph, outFile string) {
+	fmt.Print(g.ASCII())
+
+	if outFile == "" {
+		fmt.Println("Tip: go run . -graph graph.dot (Graphviz) or -graph graph.mmd (Mermaid) to save a diagram.")
+		return
+	}
+	var diagram string
+	switch filepath.Ext(outFile) {
+	case ".dot", ".gv":
+		diagram = g.DOT()
+	case ".mmd":
+		diagram = g.Mermaid()
+	case ".md":
+		diagram = "```mermaid\n" + g.Mermaid() + "```\n"
+	default:
+		fmt.Printf("\033[1;31m✗ -graph %s: use a .dot, .gv, .mmd or .md file name\033[0m\n", outFile)
+		return
+	}
+	if err := os.WriteFile(outFile, []byte(diagram), 0o644); err != nil {
+		fmt.Printf("\033[1;31m✗ %v\033[0m\n", err)
+		return
+	}
+	fmt.Printf("Wrote %s (%d nodes)\n", outFile, len(g.Nodes()))
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// outcome is what happened when a nil operation ran.
+type outcome int
+
+const (
+	works  outcome = iota // did something useful
+	zero                  // quietly gave back a zero value
+	blocks                // never finished (we gave up after a timeout)
+	panics                // crashed, and recover caught it
+)
+
+func (o outcome) String() string {
+	switch o {
+	case works:
+		return "\033[32mworks\033[0m "
+	case zero:
+		return "\033[36mzero\033[0m  "
+	case blocks:
+		return "\033[35mblocks\033[0m"
+	}
+	return "\033[31mpanic\033[0m "
+}
+
+// nilCheck is one operation on a nil value. run reports its own outcome;
+// a panic inside run is turned into a panics outcome by tryNil.
+type nilCheck struct {
+	code string
+	run  func() (outcome, string)
+}
+
+// ListNode is a linked list where nil means "empty list", so methods
+// are written to handle a nil receiver on purpose.
+type ListNode struct {
+	Value int
+	Next  *ListNode
+}
+
+// Len works on a nil *ListNode: a method call only needs the type, not a value.
+func (n *ListNode) Len() int {
+	if n == nil {
+		return 0
+	}
+	return 1 + n.Next.Len()
+}
+
+// First has no nil check, so calling it on nil dereferences nil.
+func (n *ListNode) First() int {
+	return n.Value
+}
+
+// NotFoundError is a custom error returned through the error interface.
+type NotFoundError struct {
+	Key string
+}
+
+func (e *NotFoundError) Error() string {
+	return "not found: " + e.Key
+}
+
+// lookup has the classic bug: when the key is found it returns a nil
+// *NotFoundError as an error. The interface then holds
+// (type=*NotFoundError, value=nil), and that is not equal to nil.
+func lookup(ages map[string]int, key string) error {
+	var err *NotFoundError
+	if _, ok := ages[key]; !ok {
+		err = &NotFoundError{Key: key}
+	}
+	return err
+}
+
+// nilTimeout is how long a channel operation gets before we call it blocked.
+const nilTimeout = 50 * time.Millisecond
+
+// tryNil runs a check with recover standing by, so a panic becomes a
+// table row instead of the end of the program.
+func tryNil(check nilCheck) (result outcome, detail string) {
+	defer func() {
+		if r := recover(); r != nil {
+			result, detail = panics, strings.TrimPrefix(fmt.Sprint(r), "runtime error: ")
+		}
+	}()
+	return check.run()
+}
+
+// blocksWithin runs op in its own goroutine and waits up to nilTimeout.
+// Operations on a nil channel never return, so that goroutine is simply
+// left parked until the program exits.
+func blocksWithin(op func()) (outcome, string) {
+	done := make(chan struct{})
+	go func() {
+		op()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return works, "finished"
+	case <-time.After(nilTimeout):
+		return blocks, fmt.Sprintf("still waiting after %v (forever, in real code)", nilTimeout)
+	}
+}
+
+// nilSafetySection runs every common operation on nil maps, slices,
+// pointers, channels, funcs and interfaces, and prints what really happens.
+func nilSafetySection() {
+	var (
+		nilMap      map[string]int
+		nilSlice    []string
+		nilPointer  *int
+		nilPerson   *struct{ Name string }
+		nilList     *ListNode
+		nilChan     chan int
+		nilFunc     func() int
+		nilStringer fmt.Stringer
+	)
+	ages := map[string]int{"gopher": 16}
+
+	groups := []struct {
+		title  string
+		checks []nilCheck
+	}{
+		{"Maps", []nilCheck{
+			{`nilMap["x"]`, func() (outcome, string) { return zero, fmt.Sprint(nilMap["x"]) }},
+			{`v, ok := nilMap["x"]`, func() (outcome, string) {
+				v, ok := nilMap["x"]
+				return zero, fmt.Sprintf("v=%d ok=%v", v, ok)
+			}},
+			{`len(nilMap), delete(nilMap, "x")`, func() (outcome, string) {
+				delete(nilMap, "x")
+				return works, fmt.Sprintf("len=%d, delete is a no-op", len(nilMap))
+			}},
+			{`nilMap["x"] = 1`, func() (outcome, string) { nilMap["x"] = 1; return works, "stored" }},
+		}},
+		{"Slices", []nilCheck{
+			{`append(nilSlice, "a")`, func() (outcome, string) { return works, fmt.Sprint(append(nilSlice, "a")) }},
+			{`len(nilSlice), cap(nilSlice)`, func() (outcome, string) {
+				return zero, fmt.Sprintf("%d, %d", len(nilSlice), cap(nilSlice))
+			}},
+			{`nilSlice[:0]`, func() (outcome, string) {
+				return works, fmt.Sprintf("%q (still nil: %v)", nilSlice[:0], nilSlice[:0] == nil)
+			}},
+			{`nilSlice[0]`, func() (outcome, string) { return works, nilSlice[0] }},
+		}},
+		{"Range", []nilCheck{
+			{`for range nilSlice`, func() (outcome, string) {
+				n := 0
+				for range nilSlice {
+					n++
+				}
+				return works, fmt.Sprintf("%d iterations", n)
+			}},
+			{`for range nilMap`, func() (outcome, string) {
+				n := 0
+				for range nilMap {
+					n++
+				}
+				return works, fmt.Sprintf("%d iterations", n)
+			}},
+			{`for range nilChan`, func() (outcome, string) {
+				return blocksWithin(func() {
+					for range nilChan {
+					}
+				})
+			}},
+		}},
+		{"Pointers", []nilCheck{
+			{`*nilPointer`, func() (outcome, string) { return works, fmt.Sprint(*nilPointer) }},
+			{`nilPerson.Name`, func() (outcome, string) { return works, nilPerson.Name }},
+			{`nilList.Len()  (checks for nil)`, func() (outcome, string) { return works, fmt.Sprint(nilList.Len()) }},
+			{`nilList.First() (no nil check)`, func() (outcome, string) { return works, fmt.Sprint(nilList.First()) }},
+		}},
+		{"Channels", []nilCheck{
+			{`nilChan <- 1`, func() (outcome, string) { return blocksWithin(func() { nilChan <- 1 }) }},
+			{`<-nilChan`, func() (outcome, string) { return blocksWithin(func() { <-nilChan }) }},
+			{`close(nilChan)`, func() (outcome, string) { close(nilChan); return works, "closed" }},
+			{`select { case <-nilChan: default: }`, func() (outcome, string) {
+				select {
+				case <-nilChan:
+					return works, "received"
+				default:
+					return works, "default taken: nil cases are never ready"
+				}
+			}},
+		}},
+		{"Funcs and Interfaces", []nilCheck{
+			{`nilFunc()`, func() (outcome, string) { return works, fmt.Sprint(nilFunc()) }},
+			{`nilStringer.String()`, func() (outcome, string) { return works, nilStringer.String() }},
+			{`lookup(ages, "gopher") != nil`, func() (outcome, string) {
+				err := lookup(ages, "gopher") // found, so "no error"... or so we think
+				return works, fmt.Sprintf("%v (!) it holds %T(nil)", err != nil, err)
+			}},
+			{`lookup(ages, "gopher").Error()`, func() (outcome, string) { return works, lookup(ages, "gopher").Error() }},
+		}},
+	}
+
+	for _, g := range groups {
+		fmt.Printf("%s:\n", g.title)
+		for _, check := range g.checks {
+			result, detail := tryNil(check)
+			fmt.Printf("  %-38s %s  %s\n", check.code, result, detail)
+		}
+	}
+
+	fmt.Println("Typed nil trap: an interface is nil only when both its type and value are nil.")
+	fmt.Println("  Fix: return a literal nil (return nil) instead of a nil *NotFoundError.")
+}
@@ -0,0 +1,311 @@
+// Package objgraph draws the memory behind Go values: which variables hold
+// what, where pointers, slices, maps and interfaces lead, and which pieces
+// of memory are shared. It walks values with reflect, stops at cycles, and
+// renders the result as Graphviz DOT, Mermaid, or plain text.
+//
+//	g := objgraph.New()
+//	g.Var("filledPerson", &filledPerson)
+//	g.Var("personPtr", &personPtr)
+//	fmt.Print(g.ASCII())
+package objgraph
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Limits that keep the diagram readable for big values.
+const (
+	MaxElems     = 8  // slice, array and map entries shown per node
+	MaxStringLen = 24 // longer strings are cut off with "..."
+)
+
+// Row is one line inside a node: a field, element or map entry. Rows that
+// hold a pointer, slice, map or interface to other memory have a Target.
+type Row struct {
+	Label  string
+	Value  string
+	Target string // ID of the node this row points at, or ""
+}
+
+// Node is one piece of memory: a variable, the thing a pointer points to,
+// a slice's backing array, or a map.
+type Node struct {
+	ID   string
+	Type string
+	Addr uintptr
+	Vars []string // variables whose storage is this node
+	Rows []Row
+}
+
+// Graph is the set of nodes reachable from the registered variables.
+type Graph struct {
+	nodes []*Node
+	index map[key]*Node
+}
+
+// key identifies memory by address and type: a struct and its first
+// field share an address but are different things.
+type key struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+// New returns an empty graph.
+func New() *Graph {
+	return &Graph{index: map[key]*Node{}}
+}
+
+// Var adds a variable to the graph. Pass a pointer to the variable (&x) so
+// its real address is known; that is what lets two variables that share
+// memory end up pointing at the same node.
+func (g *Graph) Var(name string, ptr any) *Graph {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		panic(fmt.Sprintf("objgraph: Var(%q) needs a non-nil pointer to the variable, got %T", name, ptr))
+	}
+	n := g.visit(v.Elem(), v.Pointer())
+	n.Vars = append(n.Vars, name)
+	return g
+}
+
+// Nodes returns the nodes in the order they were discovered.
+func (g *Graph) Nodes() []*Node {
+	return g.nodes
+}
+
+// visit returns the node for the value stored at addr, building it (and
+// everything reachable from it) the first time. The node is registered
+// before its rows are filled in, so a cycle leads back to it instead of
+// recursing forever.
+func (g *Graph) visit(v reflect.Value, addr uintptr) *Node {
+	n, isNew := g.node(addr, v.Type(), v.Type().String())
+	if isNew {
+		n.Rows = g.rows("", v)
+	}
+	return n
+}
+
+// node looks up the node for memory at addr of type t, creating an empty
+// one if this is the first time we've seen it.
+func (g *Graph) node(addr uintptr, t reflect.Type, typeName string) (n *Node, isNew bool) {
+	k := key{addr, t}
+	if n, ok := g.index[k]; ok {
+		return n, false
+	}
+	n = &Node{ID: "n" + strconv.Itoa(len(g.nodes)+1), Type: typeName, Addr: addr}
+	g.nodes = append(g.nodes, n)
+	g.index[k] = n
+	return n, true
+}
+
+// rows flattens v into labelled rows. Structs and arrays are stored
+// inline, so their fields become rows of the same node; everything that
+// refers to other memory becomes a row with an edge.
+func (g *Graph) rows(label string, v reflect.Value) []Row {
+	switch v.Kind() {
+	case reflect.Struct:
+		var rows []Row
+		for i := 0; i < v.NumField(); i++ {
+			rows = append(rows, g.rows(join(label, v.Type().Field(i).Name), v.Field(i))...)
+		}
+		if len(rows) == 0 {
+			rows = []Row{{Label: label, Value: "{}"}}
+		}
+		return rows
+	case reflect.Array:
+		var rows []Row
+		for i := 0; i < v.Len() && i < MaxElems; i++ {
+			rows = append(rows, g.rows(fmt.Sprintf("%s[%d]", label, i), v.Index(i))...)
+		}
+		if v.Len() > MaxElems {
+			rows = append(rows, Row{Label: label + "[...]", Value: fmt.Sprintf("%d more", v.Len()-MaxElems)})
+		}
+		return rows
+	}
+	return []Row{g.reference(orSelf(label), v)}
+}
+
+// reference describes a single value, following it to other memory when
+// it is a pointer, slice, map or interface.
+func (g *Graph) reference(label string, v reflect.Value) Row {
+	row := Row{Label: label}
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			row.Value = "nil"
+			return row
+		}
+		row.Value = fmt.Sprintf("%#x", v.Pointer()) // a pointer is just an address
+		row.Target = g.visit(v.Elem(), v.Pointer()).ID
+	case reflect.Slice:
+		if v.IsNil() {
+			row.Value = "nil"
+			return row
+		}
+		row.Value = fmt.Sprintf("len=%d cap=%d", v.Len(), v.Cap())
+		if v.Cap() > 0 {
+			row.Target = g.backingArray(v).ID
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			row.Value = "nil"
+			return row
+		}
+		row.Value = fmt.Sprintf("len=%d", v.Len())
+		row.Target = g.mapNode(v).ID
+	case reflect.Interface:
+		if v.IsNil() {
+			row.Value = "nil"
+			return row
+		}
+		inner := g.reference(label, v.Elem())
+		inner.Value = fmt.Sprintf("(%s) %s", v.Elem().Type(), inner.Value)
+		return inner
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		if v.IsNil() {
+			row.Value = "nil"
+		} else {
+			row.Value = fmt.Sprintf("%s @%#x", v.Kind(), v.Pointer())
+		}
+	case reflect.Struct, reflect.Array:
+		// Only reached through an interface: show it inline, one level deep.
+		row.Value = fmt.Sprintf("%s{...}", v.Type())
+	default:
+		row.Value = scalar(v)
+	}
+	return row
+}
+
+// backingArray builds the node for the array behind a slice, showing every
+// element up to cap: memory past len is still there, and another slice
+// can see it. Slices that share a backing array from the same starting
+// element share this node; a slice that starts further in (s[1:]) gets
+// its own node, because it starts at a different address.
+func (g *Graph) backingArray(v reflect.Value) *Node {
+	arrayType := reflect.ArrayOf(v.Cap(), v.Type().Elem())
+	n, isNew := g.node(v.Pointer(), arrayType, arrayType.String()+" (backing array)")
+	if !isNew {
+		return n
+	}
+	full := v.Slice(0, v.Cap())
+	for i := 0; i < full.Len() && i < MaxElems; i++ {
+		n.Rows = append(n.Rows, g.rows(fmt.Sprintf("[%d]", i), full.Index(i))...)
+	}
+	if full.Len() > MaxElems {
+		n.Rows = append(n.Rows, Row{Label: "[...]", Value: fmt.Sprintf("%d more", full.Len()-MaxElems)})
+	}
+	return n
+}
+
+// mapNode builds the node for a map's entries, sorted by key so the
+// output is stable from run to run.
+func (g *Graph) mapNode(v reflect.Value) *Node {
+	n, isNew := g.node(v.Pointer(), v.Type(), v.Type().String())
+	if !isNew {
+		return n
+	}
+
+	type entry struct {
+		label string
+		value reflect.Value
+	}
+	var entries []entry
+	for iter := v.MapRange(); iter.Next(); {
+		entries = append(entries, entry{"[" + g.reference("", iter.Key()).Value + "]", iter.Value()})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].label < entries[j].label })
+	for i, e := range entries {
+		if i == MaxElems {
+			n.Rows = append(n.Rows, Row{Label: "[...]", Value: fmt.Sprintf("%d more", len(entries)-MaxElems)})
+			break
+		}
+		n.Rows = append(n.Rows, g.rows(e.label, e.value)...)
+	}
+	return n
+}
+
+// scalar formats numbers, strings and bools without calling Interface,
+// which reflect refuses to do for unexported fields.
+func scalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		s := v.String()
+		if len(s) > MaxStringLen {
+			s = s[:MaxStringLen-3] + "..."
+		}
+		return strconv.Quote(s)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case reflect.Complex64, reflect.Complex128:
+		return strconv.FormatComplex(v.Complex(), 'g', -1, 128)
+	}
+	return v.Kind().String()
+}
+
+// Referrers lists everything that leads to node id: the variables stored
+// there and the rows of other nodes that point at it.
+func (g *Graph) Referrers(id string) []string {
+	var refs []string
+	for _, n := range g.nodes {
+		if n.ID == id {
+			refs = append(refs, n.Vars...)
+		}
+		for _, r := range n.Rows {
+			if r.Target == id {
+				refs = append(refs, g.path(n, r))
+			}
+		}
+	}
+	return refs
+}
+
+// path names a row the way you'd write it in Go: personPtr, n5[0], ring.Next.
+func (g *Graph) path(n *Node, r Row) string {
+	name := n.ID
+	if len(n.Vars) > 0 {
+		name = n.Vars[0]
+	}
+	switch {
+	case r.Label == selfLabel:
+		return name
+	case strings.HasPrefix(r.Label, "["):
+		return name + r.Label
+	}
+	return name + "." + r.Label
+}
+
+func join(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// selfLabel is the row label for a value that isn't a struct or array,
+// like the address stored in a pointer variable.
+const selfLabel = "(value)"
+
+func orSelf(label string) string {
+	if label == "" {
+		return selfLabel
+	}
+	return label
+}
+
+// title is the heading of a node: "filledPerson: main.Person".
+func title(n *Node) string {
+	if len(n.Vars) == 0 {
+		return n.Type
+	}
+	return strings.Join(n.Vars, ", ") + ": " + n.Type
+}
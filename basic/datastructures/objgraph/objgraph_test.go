@@ -0,0 +1,174 @@
+package objgraph
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+)
+
+type loop struct {
+	Name string
+	Next *loop
+}
+
+func TestSelfReferenceIsOneNode(t *testing.T) {
+	self := &loop{Name: "self"}
+	self.Next = self
+	g := New().Var("self", self)
+
+	if len(g.Nodes()) != 1 {
+		t.Fatalf("self-referential struct gave %d nodes, want 1:\n%s", len(g.Nodes()), g.ASCII())
+	}
+	n := g.Nodes()[0]
+	if next := rowNamed(t, n, "Next"); next.Target != n.ID {
+		t.Errorf("Next points at %q, want the node itself (%s)", next.Target, n.ID)
+	}
+}
+
+func TestTwoNodeCycle(t *testing.T) {
+	a, b := &loop{Name: "a"}, &loop{Name: "b"}
+	a.Next, b.Next = b, a
+	g := New().Var("a", a)
+
+	nodes := g.Nodes()
+	if len(nodes) != 2 {
+		t.Fatalf("a ⇄ b gave %d nodes, want 2:\n%s", len(nodes), g.ASCII())
+	}
+	if got := rowNamed(t, nodes[0], "Next").Target; got != nodes[1].ID {
+		t.Errorf("a.Next points at %q, want %s", got, nodes[1].ID)
+	}
+	if got := rowNamed(t, nodes[1], "Next").Target; got != nodes[0].ID {
+		t.Errorf("b.Next points at %q, want %s", got, nodes[0].ID)
+	}
+}
+
+func TestSharedPointee(t *testing.T) {
+	p := &loop{Name: "shared"}
+	x, y := p, p
+	g := New().Var("x", &x).Var("y", &y)
+
+	var targets []string
+	for _, n := range g.Nodes() {
+		if slices.Contains(n.Vars, "x") || slices.Contains(n.Vars, "y") {
+			targets = append(targets, rowNamed(t, n, selfLabel).Target)
+		}
+	}
+	if len(targets) != 2 || targets[0] == "" || targets[0] != targets[1] {
+		t.Fatalf("x and y point at %v, want one shared node:\n%s", targets, g.ASCII())
+	}
+	if len(g.Nodes()) != 3 {
+		t.Errorf("got %d nodes, want 3 (x, y and the struct they share):\n%s", len(g.Nodes()), g.ASCII())
+	}
+	if refs := g.Referrers(targets[0]); !slices.Equal(refs, []string{"x", "y"}) {
+		t.Errorf("Referrers(%s) = %v, want [x y]", targets[0], refs)
+	}
+}
+
+func TestReferenceEdges(t *testing.T) {
+	type holder struct {
+		Nums  []int
+		Ages  map[string]int
+		Thing any
+		None  any
+	}
+	h := holder{Nums: []int{1, 2}, Ages: map[string]int{"ada": 36}, Thing: &loop{Name: "boxed"}}
+	g := New().Var("h", &h)
+
+	byID := map[string]*Node{}
+	for _, n := range g.Nodes() {
+		byID[n.ID] = n
+	}
+	root := g.Nodes()[0]
+	for _, tt := range []struct {
+		row, wantType string
+	}{
+		{"Nums", "[2]int (backing array)"},
+		{"Ages", "map[string]int"},
+		{"Thing", "objgraph.loop"},
+	} {
+		target, ok := byID[rowNamed(t, root, tt.row).Target]
+		if !ok {
+			t.Errorf("h.%s has no edge:\n%s", tt.row, g.ASCII())
+			continue
+		}
+		if target.Type != tt.wantType {
+			t.Errorf("h.%s points at a %s, want %s", tt.row, target.Type, tt.wantType)
+		}
+	}
+	if none := rowNamed(t, root, "None"); none.Target != "" || none.Value != "nil" {
+		t.Errorf("nil interface row = %+v, want nil with no edge", none)
+	}
+	if ages := byID[rowNamed(t, root, "Ages").Target]; ages != nil {
+		if got := rowNamed(t, ages, "[\"ada\"]").Value; got != "36" {
+			t.Errorf(`Ages["ada"] = %s, want 36`, got)
+		}
+	}
+}
+
+// goldenGraph is small enough to check every renderer by eye: a struct
+// variable with a slice whose backing array is its only other node.
+func goldenGraph() *Graph {
+	type pair struct {
+		Name string
+		Nums []int
+	}
+	p := pair{Name: `say "hi"`, Nums: []int{1, 2}}
+	return New().Var("p", &p)
+}
+
+// addrs matches the addresses in the output, which change every run.
+var addrs = regexp.MustCompile(`0x[0-9a-f]+`)
+
+func TestRenderGolden(t *testing.T) {
+	g := goldenGraph()
+	tests := []struct {
+		name, got, want string
+	}{
+		{"ASCII", g.ASCII(), `[n1] p: objgraph.pair  @0xADDR
+     Name  "say \"hi\""
+     Nums  len=2 cap=2 ──▶ n2
+[n2] [2]int (backing array)  @0xADDR
+     [0]   1
+     [1]   2
+No shared memory: every node has exactly one owner.
+`},
+		{"DOT", g.DOT(), `digraph objgraph {
+  rankdir=LR;
+  node [shape=plaintext, fontname="monospace"];
+  n1 [label=<<table border="0" cellborder="1" cellspacing="0">
+    <tr><td colspan="2" bgcolor="#cde4ff"><b>p: objgraph.pair</b></td></tr>
+    <tr><td align="left">Name</td><td align="left" port="r0">&#34;say \&#34;hi\&#34;&#34;</td></tr>
+    <tr><td align="left">Nums</td><td align="left" port="r1">len=2 cap=2</td></tr>
+  </table>>];
+  n2 [label=<<table border="0" cellborder="1" cellspacing="0">
+    <tr><td colspan="2" bgcolor="#e8e8e8"><b>[2]int (backing array)</b></td></tr>
+    <tr><td align="left">[0]</td><td align="left" port="r0">1</td></tr>
+    <tr><td align="left">[1]</td><td align="left" port="r1">2</td></tr>
+  </table>>];
+  n1:r1 -> n2;
+}
+`},
+		{"Mermaid", g.Mermaid(), `flowchart LR
+  n1["<b>p: objgraph.pair</b><br/>Name = #quot;say \#quot;hi\#quot;#quot;<br/>Nums = len=2 cap=2"]
+  n2["<b>[2]int (backing array)</b><br/>[0] = 1<br/>[1] = 2"]
+  n1 -->|"Nums"| n2
+`},
+	}
+	for _, tt := range tests {
+		if got := addrs.ReplaceAllString(tt.got, "0xADDR"); got != tt.want {
+			t.Errorf("%s output:\n%s\nwant:\n%s", tt.name, got, tt.want)
+		}
+	}
+}
+
+// rowNamed returns n's row with the given label.
+func rowNamed(t *testing.T, n *Node, label string) Row {
+	t.Helper()
+	for _, r := range n.Rows {
+		if r.Label == label {
+			return r
+		}
+	}
+	t.Fatalf("node %s (%s) has no row %q", n.ID, n.Type, label)
+	return Row{}
+}
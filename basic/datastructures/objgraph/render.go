@@ -0,0 +1,119 @@
+package objgraph
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// ASCII renders the graph as text for the terminal: one box per node,
+// arrows as "──▶ nX", followed by the memory that more than one thing
+// refers to.
+func (g *Graph) ASCII() string {
+	var b strings.Builder
+	labelWidth := 0
+	for _, n := range g.nodes {
+		for _, r := range n.Rows {
+			labelWidth = max(labelWidth, len(r.Label))
+		}
+	}
+
+	for _, n := range g.nodes {
+		fmt.Fprintf(&b, "[%s] %s  @%#x\n", n.ID, title(n), n.Addr)
+		for _, r := range n.Rows {
+			value := r.Value
+			if r.Target != "" {
+				value += " ──▶ " + r.Target + g.targetName(r.Target)
+			}
+			fmt.Fprintf(&b, "     %-*s  %s\n", labelWidth, r.Label, value)
+		}
+	}
+
+	shared := false
+	for _, n := range g.nodes {
+		refs := g.Referrers(n.ID)
+		if len(refs) < 2 {
+			continue
+		}
+		if !shared {
+			b.WriteString("Shared memory (one piece of memory, several names):\n")
+			shared = true
+		}
+		fmt.Fprintf(&b, "  [%s] %s ◀── %s\n", n.ID, n.Type, strings.Join(refs, ", "))
+	}
+	if !shared {
+		b.WriteString("No shared memory: every node has exactly one owner.\n")
+	}
+	return b.String()
+}
+
+// targetName adds " (var)" after an arrow when the target is a variable.
+func (g *Graph) targetName(id string) string {
+	for _, n := range g.nodes {
+		if n.ID == id && len(n.Vars) > 0 {
+			return " (" + strings.Join(n.Vars, ", ") + ")"
+		}
+	}
+	return ""
+}
+
+// DOT renders the graph in Graphviz format. Each node is a table with one
+// row per field; edges start at the row that holds the reference.
+//
+//	go run . -graph graph.dot && dot -Tsvg graph.dot > graph.svg
+func (g *Graph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph objgraph {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=plaintext, fontname=\"monospace\"];\n")
+	for _, n := range g.nodes {
+		color := "#e8e8e8"
+		if len(n.Vars) > 0 {
+			color = "#cde4ff" // variables stand out from heap objects
+		}
+		fmt.Fprintf(&b, "  %s [label=<<table border=\"0\" cellborder=\"1\" cellspacing=\"0\">\n", n.ID)
+		fmt.Fprintf(&b, "    <tr><td colspan=\"2\" bgcolor=\"%s\"><b>%s</b></td></tr>\n", color, html.EscapeString(title(n)))
+		for i, r := range n.Rows {
+			fmt.Fprintf(&b, "    <tr><td align=\"left\">%s</td><td align=\"left\" port=\"r%d\">%s</td></tr>\n",
+				html.EscapeString(r.Label), i, html.EscapeString(r.Value))
+		}
+		b.WriteString("  </table>>];\n")
+	}
+	for _, n := range g.nodes {
+		for i, r := range n.Rows {
+			if r.Target != "" {
+				fmt.Fprintf(&b, "  %s:r%d -> %s;\n", n.ID, i, r.Target)
+			}
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid renders the graph as a Mermaid flowchart, which GitHub displays
+// directly inside a ```mermaid code block in markdown.
+func (g *Graph) Mermaid() string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for _, n := range g.nodes {
+		lines := []string{"<b>" + mermaidEscape(title(n)) + "</b>"}
+		for _, r := range n.Rows {
+			lines = append(lines, mermaidEscape(r.Label+" = "+r.Value))
+		}
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", n.ID, strings.Join(lines, "<br/>"))
+	}
+	for _, n := range g.nodes {
+		for _, r := range n.Rows {
+			if r.Target != "" {
+				fmt.Fprintf(&b, "  %s -->|\"%s\"| %s\n", n.ID, mermaidEscape(r.Label), r.Target)
+			}
+		}
+	}
+	return b.String()
+}
+
+// mermaidEscape replaces the characters that end a Mermaid label early
+// with the entity codes Mermaid understands.
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;", "|", "#124;").Replace(s)
+}
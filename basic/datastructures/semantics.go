@@ -0,0 +1,225 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+	"unsafe"
+)
+
+// Every argument in Go is passed by value: the function gets a copy.
+// What differs is what the copy contains. An array or struct copy holds
+// all the data; a slice, map or pointer copy holds a way to reach the
+// caller's data.
+
+func modifyArray(a [3]int)         { a[0] = 99 }
+func modifyArrayPtr(a *[3]int)     { a[0] = 99 }
+func modifySlice(s []int)          { s[0] = 99 }
+func appendSlice(s []int)          { s = append(s, 4); s[0] = 99 } // append grows a copy of the header
+func modifyMap(m map[string]int)   { m["a"] = 99 }
+func replaceMap(m map[string]int)  { m = map[string]int{"a": 99} } // only the local copy changes
+func modifyPoint(p point)          { p.X = 99 }
+func modifyPointPtr(p *point)      { p.X = 99 }
+func modifyTagged(t tagged)        { t.Tags[0] = "changed" }
+func replaceTaggedTags(t tagged)   { t.Tags = []string{"changed"} }
+func modifyPointerArg(p *point)    { p = &point{X: 99} } // repoints the copy, not the caller's pointer
+func modifyViaPointerArg(p *point) { *p = point{X: 99} }
+
+type point struct{ X, Y int }
+
+// tagged is a struct with a slice inside: copying the struct copies the
+// slice header, so both copies still share one backing array.
+type tagged struct {
+	Name string
+	Tags []string
+}
+
+// valueSemanticsSection passes each kind of value to a function that
+// mutates it and shows whether the caller notices, then (with -bench)
+// measures when copying becomes more expensive than passing a pointer.
+func valueSemanticsSection(runBench bool) {
+	fmt.Printf("  %-42s | %-16s | %s\n", "Function Call", "Caller After", "Caller Sees It?")
+
+	row := func(call, after string, changed bool) {
+		verdict := "\033[90mno, it changed a copy\033[0m"
+		if changed {
+			verdict = "\033[33myes, shared data\033[0m"
+		}
+		fmt.Printf("  %-42s | %-16s | %s\n", call, after, verdict)
+	}
+
+	arr := [3]int{1, 2, 3}
+	modifyArray(arr)
+	row("modifyArray(arr)       a[0] = 99", fmt.Sprint(arr), arr[0] == 99)
+	modifyArrayPtr(&arr)
+	row("modifyArrayPtr(&arr)   a[0] = 99", fmt.Sprint(arr), arr[0] == 99)
+
+	s := []int{1, 2, 3}
+	modifySlice(s)
+	row("modifySlice(s)         s[0] = 99", fmt.Sprint(s), s[0] == 99)
+	s2 := []int{1, 2, 3}
+	appendSlice(s2)
+	row("appendSlice(s)         append; s[0] = 99", fmt.Sprint(s2), s2[0] == 99 || len(s2) == 4)
+
+	m := map[string]int{"a": 1}
+	modifyMap(m)
+	row(`modifyMap(m)           m["a"] = 99`, fmt.Sprint(m), m["a"] == 99)
+	m2 := map[string]int{"a": 1}
+	replaceMap(m2)
+	row("replaceMap(m)          m = new map", fmt.Sprint(m2), m2["a"] == 99)
+
+	p := point{1, 2}
+	modifyPoint(p)
+	row("modifyPoint(p)         p.X = 99", fmt.Sprint(p), p.X == 99)
+	modifyPointPtr(&p)
+	row("modifyPointPtr(&p)     p.X = 99", fmt.Sprint(p), p.X == 99)
+	q := point{1, 2}
+	modifyPointerArg(&q)
+	row("modifyPointerArg(&p)   p = &point{99}", fmt.Sprint(q), q.X == 99)
+	modifyViaPointerArg(&q)
+	row("modifyViaPointerArg(&p) *p = point{99}", fmt.Sprint(q), q.X == 99)
+
+	t := tagged{Name: "go", Tags: []string{"fast"}}
+	modifyTagged(t)
+	row(`modifyTagged(t)        t.Tags[0] = ...`, fmt.Sprint(t), t.Tags[0] == "changed")
+	t2 := tagged{Name: "go", Tags: []string{"fast"}}
+	replaceTaggedTags(t2)
+	row(`replaceTaggedTags(t)   t.Tags = ...`, fmt.Sprint(t2), t2.Tags[0] == "changed")
+
+	fmt.Println("Rule of thumb: the copy is shallow. Arrays and structs copy their contents;")
+	fmt.Println("slices, maps and pointers copy a reference, so writes through it are shared,")
+	fmt.Println("but reassigning the parameter itself (s = append..., m = ..., p = ...) never reaches the caller.")
+
+	fmt.Println("\nBenchmark: copy by value vs pass a pointer:")
+	if !runBench {
+		fmt.Println("  \033[90m(skipped - run with -bench to find the crossover on this machine)\033[0m")
+		return
+	}
+	copyCostBenchmarks()
+}
+
+// record is a struct that grows with its payload, like a Person with
+// more and more fields.
+type record[P any] struct {
+	ID      int64
+	Name    string
+	Payload P
+}
+
+//go:noinline
+func takeValue[T any](v T) {}
+
+//go:noinline
+func takePointer[T any](p *T) {}
+
+func benchValue[T any](b *testing.B) {
+	var v T
+	for range b.N {
+		takeValue(v)
+	}
+}
+
+func benchPointer[T any](b *testing.B) {
+	var v T
+	for range b.N {
+		takePointer(&v)
+	}
+}
+
+// copyCase benchmarks passing one type by value and by pointer.
+type copyCase struct {
+	size    int
+	byValue func(b *testing.B)
+	byPtr   func(b *testing.B)
+}
+
+// caseFor measures T's size rather than trusting a label, since padding
+// and string headers make struct sizes easy to get wrong.
+func caseFor[T any]() copyCase {
+	return copyCase{size: int(unsafe.Sizeof(*new(T))), byValue: benchValue[T], byPtr: benchPointer[T]}
+}
+
+// copySeries are the sizes measured both here and by the benchmarks in
+// semantics_test.go.
+var copySeries = []struct {
+	name  string
+	cases []copyCase
+}{
+	{"[N]byte arrays", []copyCase{
+		caseFor[[8]byte](), caseFor[[16]byte](), caseFor[[32]byte](), caseFor[[64]byte](),
+		caseFor[[128]byte](), caseFor[[256]byte](), caseFor[[512]byte](),
+		caseFor[[1024]byte](), caseFor[[4096]byte](), caseFor[[16384]byte](),
+	}},
+	{"structs (ID, Name, Payload)", []copyCase{
+		caseFor[record[struct{}]](), caseFor[record[[5]int64]](),
+		caseFor[record[[13]int64]](), caseFor[record[[29]int64]](), caseFor[record[[61]int64]](),
+		caseFor[record[[125]int64]](), caseFor[record[[509]int64]](), caseFor[record[[2045]int64]](),
+	}},
+}
+
+// significant is how much faster one side must be to count as a win.
+const significant = 1.5
+
+// copyCostBenchmarks times both ways of passing arrays and structs of
+// growing size, and reports the first size where the pointer wins for
+// good. The exact crossover depends on the CPU, so it's measured, not assumed.
+func copyCostBenchmarks() {
+	testing.Init() // registers the -test.* flags so the run time can be shortened
+	if err := flag.Set("test.benchtime", "100ms"); err != nil {
+		fmt.Printf("  \033[1;31m✗ can't shorten the benchmarks: %v\033[0m\n", err)
+		return
+	}
+
+	fmt.Printf("  A winner has to be at least %.1fx faster; anything closer is call overhead and noise.\n", significant)
+	for _, sr := range copySeries {
+		fmt.Printf("  %s:\n", sr.name)
+		fmt.Printf("    %-10s | %12s | %12s | %s\n", "Size", "By Value", "By Pointer", "Winner")
+		crossover := -1
+		for _, c := range sr.cases {
+			value, ptr := nsPerOp(c.byValue), nsPerOp(c.byPtr)
+			winner := "\033[90mabout the same\033[0m"
+			switch {
+			case value > ptr*significant:
+				winner = "\033[33mpointer\033[0m"
+				if crossover < 0 {
+					crossover = c.size
+				}
+			case ptr > value*significant:
+				winner = "\033[32mvalue\033[0m"
+				crossover = -1
+			default:
+				crossover = -1
+			}
+			fmt.Printf("    %-10s | %9.2f ns | %9.2f ns | %s\n", formatBytes(c.size), value, ptr, winner)
+		}
+		if crossover < 0 {
+			fmt.Println("    Crossover: pointer never pulled ahead in this range")
+		} else {
+			fmt.Printf("    Crossover: from about %s, passing a pointer is cheaper on this machine\n", formatBytes(crossover))
+		}
+	}
+	fmt.Println("  Below the crossover, prefer values: they're simpler, safer, and stay on the stack.")
+	fmt.Println("  A pointer can cost more elsewhere: it may force the value onto the heap (see escape analysis).")
+}
+
+// nsPerOp runs a benchmark a few times and returns the best nanoseconds
+// per operation as a float, since these calls are often faster than the
+// whole nanoseconds NsPerOp rounds to. The best run is the one least
+// disturbed by whatever else the machine was doing.
+func nsPerOp(fn func(b *testing.B)) float64 {
+	best := 0.0
+	for range 3 {
+		r := testing.Benchmark(fn)
+		if ns := float64(r.T.Nanoseconds()) / float64(r.N); best == 0 || ns < best {
+			best = ns
+		}
+	}
+	return best
+}
+
+func formatBytes(n int) string {
+	if n >= 1024 {
+		return fmt.Sprintf("%d KB", n/1024)
+	}
+	return fmt.Sprintf("%d B", n)
+}
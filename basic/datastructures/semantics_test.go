@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+// The same copy-cost cases as go run . -bench, as ordinary benchmarks.
+// Compare the value and pointer results for each size:
+//
+//	go test -bench=Copy
+
+func runCopyCases(b *testing.B, cases []copyCase) {
+	for _, c := range cases {
+		b.Run(fmt.Sprintf("%dB", c.size), func(b *testing.B) {
+			b.Run("value", c.byValue)
+			b.Run("pointer", c.byPtr)
+		})
+	}
+}
+
+func BenchmarkCopyArray(b *testing.B) { runCopyCases(b, copySeries[0].cases) }
+
+func BenchmarkCopyStruct(b *testing.B) { runCopyCases(b, copySeries[1].cases) }
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+const divider = "─────────────────────────────────────────────────────────────────────────────"
+
+// bigNumbersSection picks up where uint64 and float64 run out, using the
+// arbitrary-precision types from math/big.
+func bigNumbersSection() {
+	// --- 10. big.Int ---
+	// big.Int grows as needed, so it never overflows. Operations are methods
+	// that write into the receiver: z.Mul(x, y) means z = x * y.
+	fmt.Printf("\n\033[1;33m▶ BIG INTEGERS (math/big.Int - no upper limit)\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-6s | %-22s | %-40s\n", "n", "n! as uint64", "n! as big.Int")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	var factU uint64 = 1
+	factBig := big.NewInt(1)
+	for n := uint64(1); n <= 25; n++ {
+		factU *= n
+		factBig.Mul(factBig, new(big.Int).SetUint64(n))
+		if n < 19 {
+			continue
+		}
+		status := "\033[32m✓\033[0m"
+		if !factBig.IsUint64() || factBig.Uint64() != factU {
+			status = "\033[31m✗ overflowed\033[0m"
+		}
+		fmt.Printf("%-6d | %-22d | %-40s %s\n", n, factU, factBig, status)
+	}
+
+	fmt.Printf("\n%-6s | %-22s | %-40s\n", "n", "Fibonacci as uint64", "Fibonacci as big.Int")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	var a, b uint64 = 0, 1
+	bigA, bigB := big.NewInt(0), big.NewInt(1)
+	for n := 1; n <= 100; n++ {
+		a, b = b, a+b
+		bigA.Add(bigA, bigB)
+		bigA, bigB = bigB, bigA
+		if n == 92 || n == 93 || n == 94 || n == 100 {
+			status := "\033[32m✓\033[0m"
+			if !bigA.IsUint64() || bigA.Uint64() != a {
+				status = "\033[31m✗ overflowed\033[0m"
+			}
+			fmt.Printf("%-6d | %-22d | %-40s %s\n", n, a, bigA, status)
+		}
+	}
+	huge := new(big.Int).Exp(big.NewInt(2), big.NewInt(256), nil)
+	fmt.Printf("2^256 = %s (%d bits, %d decimal digits)\n", huge, huge.BitLen(), len(huge.String()))
+
+	// --- 10.1 big.Float ---
+	// big.Float lets you choose the mantissa precision in bits (float64 has 53)
+	// and how results are rounded when they don't fit.
+	fmt.Printf("\n\033[1;32m▶ BIG FLOATS (math/big.Float - choose your precision)\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-15s | %-60s\n", "Precision", "√2")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-15s | %-60.50f\n", "float64 (53)", math.Sqrt2)
+	for _, prec := range []uint{24, 53, 100, 200} {
+		two := new(big.Float).SetPrec(prec).SetInt64(2)
+		root := new(big.Float).SetPrec(prec).Sqrt(two)
+		fmt.Printf("%-15s | %-60s\n", fmt.Sprintf("big (%d bits)", prec), root.Text('f', 50))
+	}
+
+	fmt.Printf("\n%-15s | %-12s | %-12s | %-10s\n", "Rounding Mode", "+2.5 → 2 bits", "-2.5 → 2 bits", "Accuracy (+)")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	modes := []big.RoundingMode{big.ToNearestEven, big.ToNearestAway, big.ToZero, big.AwayFromZero, big.ToNegativeInf, big.ToPositiveInf}
+	for _, mode := range modes {
+		// 2.5 is 10.1 in binary and needs 3 bits, so a 2-bit mantissa must round.
+		pos := new(big.Float).SetPrec(2).SetMode(mode).SetFloat64(2.5)
+		neg := new(big.Float).SetPrec(2).SetMode(mode).SetFloat64(-2.5)
+		fmt.Printf("%-15s | %-12s | %-12s | %-10s\n", mode, pos.Text('g', 5), neg.Text('g', 5), pos.Acc())
+	}
+
+	// --- 10.2 big.Rat ---
+	// big.Rat stores an exact fraction (numerator/denominator), so decimal
+	// fractions like 0.1 have no rounding error at all.
+	fmt.Printf("\n\033[1;35m▶ RATIONAL NUMBERS (math/big.Rat - exact fractions)\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	f1, f2 := 0.1, 0.2
+	fmt.Printf("%-25s | %-25v | %-10s\n", "float64: 0.1 + 0.2", f1+f2, fmt.Sprint(f1+f2 == 0.3))
+	r1, _ := new(big.Rat).SetString("0.1")
+	r2, _ := new(big.Rat).SetString("0.2")
+	r3, _ := new(big.Rat).SetString("0.3")
+	sum := new(big.Rat).Add(r1, r2)
+	fmt.Printf("%-25s | %-25s | %-10v\n", "big.Rat: 1/10 + 2/10", sum.RatString(), sum.Cmp(r3) == 0)
+	fmt.Printf("Equal to 0.3? float64 says %v, big.Rat says %v\n", f1+f2 == 0.3, sum.Cmp(r3) == 0)
+	third := big.NewRat(1, 3)
+	tripled := new(big.Rat).Mul(third, big.NewRat(3, 1))
+	fmt.Printf("1/3 * 3 = %s (exact), as decimal: %s\n", tripled.RatString(), third.FloatString(20))
+
+	// --- 10.3 Converting back to built-in types ---
+	// Every conversion reports whether it was exact, so you know when you lost data.
+	fmt.Printf("\n\033[1;36m▶ CONVERTING BIG ↔ BUILT-IN TYPES (with accuracy)\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-34s | %-24s | %-15s\n", "Conversion", "Result", "Accuracy")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+
+	small := big.NewInt(42)
+	fmt.Printf("%-34s | %-24d | %-15s\n", "big.Int(42).Int64()", small.Int64(), exactness(small.IsInt64()))
+	fmt.Printf("%-34s | %-24d | %-15s\n", "big.Int(2^256).Uint64()", huge.Uint64(), exactness(huge.IsUint64()))
+
+	pi := new(big.Float).SetPrec(200)
+	pi.SetString("3.14159265358979323846264338327950288419716939937510")
+	piF64, acc := pi.Float64()
+	fmt.Printf("%-34s | %-24.17g | %-15s\n", "big.Float(π).Float64()", piF64, acc)
+	piF32, acc := pi.Float32()
+	fmt.Printf("%-34s | %-24.9g | %-15s\n", "big.Float(π).Float32()", piF32, acc)
+	piInt, acc := pi.Int64()
+	fmt.Printf("%-34s | %-24d | %-15s\n", "big.Float(π).Int64()", piInt, acc)
+	fromF64 := new(big.Float).SetFloat64(0.1)
+	fmt.Printf("%-34s | %-24s | %-15s\n", "big.Float.SetFloat64(0.1)", fromF64.Text('g', 20), "Exact (of 0.1's float64 bits)")
+
+	tenth := big.NewRat(1, 10)
+	tenthF, exact := tenth.Float64()
+	fmt.Printf("%-34s | %-24v | %-15s\n", "big.Rat(1/10).Float64()", tenthF, exactness(exact))
+	half := big.NewRat(1, 2)
+	halfF, exact := half.Float64()
+	fmt.Printf("%-34s | %-24v | %-15s\n", "big.Rat(1/2).Float64()", halfF, exactness(exact))
+	backToRat := new(big.Rat).SetFloat64(0.1)
+	fmt.Printf("%-34s | %-24s | %-15s\n", "big.Rat.SetFloat64(0.1)", backToRat.RatString()[:20]+"…", "Exact (shows the real 0.1)")
+}
+
+// exactness turns the bool returned by IsInt64, Rat.Float64 and friends into
+// the same wording big.Accuracy uses.
+func exactness(exact bool) string {
+	if exact {
+		return "Exact"
+	}
+	return "\033[31mInexact\033[0m"
+}
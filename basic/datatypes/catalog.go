@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"runtime"
+)
+
+// universeDescriptions gives a one-line explanation for each predeclared
+// identifier we know about. Anything a newer toolchain adds still shows up
+// in the catalog, just with a placeholder description.
+var universeDescriptions = map[string]string{
+	// types
+	"bool":       "true or false",
+	"byte":       "alias for uint8, raw bytes",
+	"rune":       "alias for int32, a Unicode code point",
+	"int":        "signed integer, 32 or 64 bits by platform",
+	"int8":       "signed 8-bit integer",
+	"int16":      "signed 16-bit integer",
+	"int32":      "signed 32-bit integer",
+	"int64":      "signed 64-bit integer",
+	"uint":       "unsigned integer, 32 or 64 bits by platform",
+	"uint8":      "unsigned 8-bit integer",
+	"uint16":     "unsigned 16-bit integer",
+	"uint32":     "unsigned 32-bit integer",
+	"uint64":     "unsigned 64-bit integer",
+	"uintptr":    "unsigned integer big enough to hold a pointer",
+	"float32":    "IEEE 754 32-bit float",
+	"float64":    "IEEE 754 64-bit float",
+	"complex64":  "two float32: real and imaginary",
+	"complex128": "two float64: real and imaginary",
+	"string":     "immutable sequence of bytes, usually UTF-8",
+	"error":      "interface { Error() string }",
+	"any":        "alias for interface{}, holds any value",
+	"comparable": "constraint for types usable with == (generics only)",
+	// constants
+	"true":  "the boolean truth value",
+	"false": "the boolean false value",
+	"iota":  "counter that resets to 0 in each const block",
+	// zero value
+	"nil": "zero value for pointers, slices, maps, chans, funcs, interfaces",
+	// built-in functions
+	"append":  "append elements to a slice, growing it if needed",
+	"cap":     "capacity of a slice, array or channel",
+	"clear":   "delete all map entries or zero all slice elements",
+	"close":   "close a channel",
+	"complex": "build a complex number from two floats",
+	"copy":    "copy elements between slices, returns count",
+	"delete":  "remove a key from a map",
+	"imag":    "imaginary part of a complex number",
+	"len":     "length of a string, slice, array, map or channel",
+	"make":    "allocate and initialize a slice, map or channel",
+	"max":     "largest of its arguments",
+	"min":     "smallest of its arguments",
+	"new":     "allocate a zeroed value and return a pointer to it",
+	"panic":   "stop normal execution and start unwinding",
+	"print":   "low-level print to stderr (for bootstrapping)",
+	"println": "low-level println to stderr (for bootstrapping)",
+	"real":    "real part of a complex number",
+	"recover": "regain control of a panicking goroutine",
+}
+
+// printCatalog enumerates types.Universe, the scope the compiler uses for
+// predeclared identifiers, and prints every entry grouped by kind.
+func printCatalog() {
+	sizes := types.SizesFor("gc", runtime.GOARCH)
+
+	var typeNames, constNames, builtinNames, otherNames []string
+	for _, name := range types.Universe.Names() { // already sorted
+		switch types.Universe.Lookup(name).(type) {
+		case *types.TypeName:
+			typeNames = append(typeNames, name)
+		case *types.Const:
+			constNames = append(constNames, name)
+		case *types.Builtin:
+			builtinNames = append(builtinNames, name)
+		default:
+			otherNames = append(otherNames, name)
+		}
+	}
+
+	fmt.Printf("\n\033[1;36m╔════════════════════════════════════════════════════════════════╗\033[0m\n")
+	fmt.Printf("\033[1;36m║       PREDECLARED IDENTIFIER CATALOG (go/types.Universe)       ║\033[0m\n")
+	fmt.Printf("\033[1;36m╚════════════════════════════════════════════════════════════════╝\033[0m\n")
+	fmt.Printf("Toolchain: %s | OS: %s | Architecture: %s | %d identifiers\n", runtime.Version(), runtime.GOOS, runtime.GOARCH, len(types.Universe.Names()))
+
+	fmt.Printf("\n\033[1;33m▶ PREDECLARED TYPES (%d)\033[0m\n", len(typeNames))
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-11s | %-4s | %-5s | %-6s | %-25s | %s\n", "Name", "Size", "Align", "Zero", "Underlying", "Description")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	for _, name := range typeNames {
+		obj := types.Universe.Lookup(name).(*types.TypeName)
+		t := obj.Type()
+		size, align := fmt.Sprint(sizes.Sizeof(t)), fmt.Sprint(sizes.Alignof(t))
+		if iface, ok := t.Underlying().(*types.Interface); ok && !iface.IsMethodSet() {
+			size, align = "-", "-" // constraint interfaces can't be used as value types
+		}
+		underlying := types.TypeString(t.Underlying(), nil)
+		if b, ok := t.Underlying().(*types.Basic); ok {
+			underlying = types.Typ[b.Kind()].Name() // byte and rune report their own names otherwise
+		}
+		if obj.IsAlias() {
+			underlying += " (alias)"
+		}
+		fmt.Printf("%-11s | %-4s | %-5s | %-6s | %-25s | %s\n", name, size, align, zeroValueOf(t), underlying, describe(name))
+	}
+
+	fmt.Printf("\n\033[1;35m▶ PREDECLARED CONSTANTS (%d)\033[0m\n", len(constNames))
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-11s | %-15s | %-10s | %s\n", "Name", "Type", "Value", "Description")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	for _, name := range constNames {
+		c := types.Universe.Lookup(name).(*types.Const)
+		fmt.Printf("%-11s | %-15s | %-10s | %s\n", name, c.Type(), c.Val(), describe(name))
+	}
+
+	fmt.Printf("\n\033[1;32m▶ BUILT-IN FUNCTIONS (%d)\033[0m\n", len(builtinNames))
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-11s | %s\n", "Name", "Description")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	for _, name := range builtinNames {
+		fmt.Printf("%-11s | %s\n", name, describe(name))
+	}
+
+	fmt.Printf("\n\033[1;31m▶ OTHER (%d)\033[0m\n", len(otherNames))
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	for _, name := range otherNames {
+		fmt.Printf("%-11s | %-15T | %s\n", name, types.Universe.Lookup(name), describe(name))
+	}
+	fmt.Println()
+}
+
+// zeroValueOf prints the zero value a variable of type t starts with.
+func zeroValueOf(t types.Type) string {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return "false"
+		case u.Info()&types.IsString != 0:
+			return `""`
+		case u.Info()&types.IsComplex != 0:
+			return "(0+0i)"
+		case u.Info()&types.IsFloat != 0:
+			return "0.0"
+		case u.Info()&types.IsNumeric != 0:
+			return "0"
+		}
+	case *types.Interface:
+		if !u.IsMethodSet() {
+			return "-"
+		}
+		return "nil"
+	}
+	return "?"
+}
+
+// describe looks up a description, flagging identifiers this guide
+// hasn't heard of yet instead of hiding them.
+func describe(name string) string {
+	if d, ok := universeDescriptions[name]; ok {
+		return d
+	}
+	return "\033[90m(new in this toolchain, not described yet)\033[0m"
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"strings"
+	"unsafe"
+)
+
+// complexSection covers complex64 and complex128: construction, the
+// real/imag built-ins, math/cmplx, formatting, and a Mandelbrot set drawn
+// in the terminal as a small real-world use.
+func complexSection() {
+	// --- 2.1 Complex Numbers ---
+	// A complex number is a pair of floats: a real part and an imaginary part
+	// Use when: signal processing, electrical engineering, fractals, 2D rotations
+	var c64 complex64 = complex(1.5, -2) // complex() builds one from two floats
+	c128 := 3 + 4i                       // literal with an imaginary part, defaults to complex128
+	var zeroComplex complex128
+
+	fmt.Printf("\n\033[1;32m▶ COMPLEX NUMBERS (real + imaginary parts)\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-15s | %-30s | %-15s | %-10s\n", "Type", "Made Of", "Example Value", "Size(bytes)")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-15s | %-30s | %-15s | %-10d\n", "complex64", "two float32 (real, imag)", fmt.Sprint(c64), unsafe.Sizeof(c64))
+	fmt.Printf("%-15s | %-30s | %-15s | %-10d\n", "complex128", "two float64 (real, imag)", fmt.Sprint(c128), unsafe.Sizeof(c128))
+	fmt.Printf("%-15s | %-30s | %-15s | %-10d\n", "zero value", "complex128", fmt.Sprint(zeroComplex), unsafe.Sizeof(zeroComplex))
+
+	fmt.Printf("\n%-30s | %-30s\n", "Expression", "Result")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-30s | %-30v\n", "real(3+4i)", real(c128))
+	fmt.Printf("%-30s | %-30v\n", "imag(3+4i)", imag(c128))
+	fmt.Printf("%-30s | %-30T\n", "real(complex64) type", real(c64))
+	fmt.Printf("%-30s | %-30s\n", "(3+4i) * (1-2i)", fmt.Sprint(c128*(1-2i)))
+	fmt.Printf("%-30s | %-30s\n", "i * i", fmt.Sprint(1i*1i))
+	fmt.Printf("%-30s | %-30v\n", "cmplx.Abs(3+4i)", cmplx.Abs(c128))
+	fmt.Printf("%-30s | %-30.4f\n", "cmplx.Phase(3+4i) (radians)", cmplx.Phase(c128))
+	fmt.Printf("%-30s | %-30s\n", "cmplx.Sqrt(-1)", fmt.Sprint(cmplx.Sqrt(-1)))
+	fmt.Printf("%-30s | %-30s\n", "cmplx.Conj(3+4i)", fmt.Sprint(cmplx.Conj(c128)))
+	fmt.Printf("%-30s | %-30s\n", "cmplx.Exp(iπ) + 1 (Euler)", fmt.Sprintf("%.3g", cmplx.Exp(complex(0, math.Pi))+1))
+	r, theta := cmplx.Polar(c128)
+	fmt.Printf("%-30s | r = %v, θ = %.4f\n", "cmplx.Polar(3+4i)", r, theta)
+	fmt.Printf("%-30s | %-30s\n", "cmplx.Rect(r, θ)", fmt.Sprintf("%.4f", cmplx.Rect(r, theta)))
+
+	fmt.Printf("\n%-10s | %-30s\n", "Verb", "fmt output for 3+4i")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	for _, verb := range []string{"%v", "%.2f", "%e", "%g", "%+.1f", "%8.1f"} {
+		fmt.Printf("%-10s | %-30s\n", verb, fmt.Sprintf(verb, c128))
+	}
+
+	fmt.Printf("\n\033[1;32m▶ USE CASE: THE MANDELBROT SET (z = z² + c)\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	drawMandelbrot(76, 24, 60)
+	fmt.Println("Each character is a complex number c. Solid blocks mean z stayed small forever (inside the set);")
+	fmt.Println("colors show how fast z escaped past |z| > 2.")
+}
+
+// drawMandelbrot renders the set with the guide's ANSI colors, one
+// character per point, using nothing but complex128 arithmetic.
+func drawMandelbrot(width, height, maxIter int) {
+	palette := []string{"\033[90m", "\033[34m", "\033[36m", "\033[32m", "\033[33m", "\033[35m", "\033[31m", "\033[1;37m"}
+	const (
+		minRe, maxRe = -2.2, 0.8
+		minIm, maxIm = -1.2, 1.2
+	)
+	for row := 0; row < height; row++ {
+		var line strings.Builder
+		im := maxIm - (maxIm-minIm)*float64(row)/float64(height-1)
+		for col := 0; col < width; col++ {
+			re := minRe + (maxRe-minRe)*float64(col)/float64(width-1)
+			c := complex(re, im)
+			z := complex128(0)
+			n := 0
+			for ; n < maxIter && cmplx.Abs(z) <= 2; n++ {
+				z = z*z + c
+			}
+			if n == maxIter {
+				line.WriteString("\033[0m█")
+				continue
+			}
+			line.WriteString(palette[n%len(palette)])
+			line.WriteString("·")
+		}
+		line.WriteString("\033[0m")
+		fmt.Println(line.String())
+	}
+}
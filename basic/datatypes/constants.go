@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// Bit flags: each constant gets its own bit, so they can be combined with |.
+type Permission uint8
+
+const (
+	Read    Permission = 1 << iota // 1 << 0 = 1
+	Write                          // 1 << 1 = 2 (the expression repeats with the next iota)
+	Execute                        // 1 << 2 = 4
+)
+
+// String lists the flags that are set, like "rw-".
+func (p Permission) String() string {
+	flags := []byte("---")
+	for i, c := range "rwx" {
+		if p&(1<<i) != 0 {
+			flags[i] = byte(c)
+		}
+	}
+	return string(flags)
+}
+
+// Skipping values with the blank identifier.
+const (
+	_      = iota      // 0 is skipped so the zero value means "unset"
+	Low                // 1
+	_                  // 2 is reserved
+	High               // 3
+	Urgent = iota * 10 // 4 * 10 = 40: a new expression replaces the repeated one
+)
+
+// KB, MB, GB: iota inside a larger expression.
+type ByteSize uint64
+
+const (
+	_           = iota
+	KB ByteSize = 1 << (10 * iota) // 1 << 10
+	MB                             // 1 << 20
+	GB                             // 1 << 30
+	TB                             // 1 << 40
+)
+
+// Expression reuse: iota is the same for every name on one line.
+const (
+	First, FirstSquared   = iota + 1, (iota + 1) * (iota + 1) // 1, 1
+	Second, SecondSquared                                     // 2, 4
+	Third, ThirdSquared                                       // 3, 9
+)
+
+// untypedConstantsSection explains the six kinds of untyped constants, the
+// default type each one falls back to, and the iota patterns above. The
+// compile-time arithmetic is evaluated with go/types and go/constant so it
+// can be compared with the same expression at run time.
+func untypedConstantsSection() {
+	// --- 3.1 Untyped Constants ---
+	// A constant without a type is just an exact value until it's used.
+	// It gets its "default type" only when something needs a concrete one.
+	b, r, i, f, c, s := true, 'G', 42, 3.14, 2i, "Go"
+
+	fmt.Printf("\n\033[1;35m▶ UNTYPED CONSTANTS (exact values until they need a type)\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-15s | %-15s | %-20s | %-15s\n", "Literal", "Kind", "Default Type", "x := literal")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-15s | %-15s | %-20s | %-15T\n", "true", "untyped bool", "bool", b)
+	fmt.Printf("%-15s | %-15s | %-20s | %-15T\n", "'G'", "untyped rune", "rune (int32)", r)
+	fmt.Printf("%-15s | %-15s | %-20s | %-15T\n", "42", "untyped int", "int", i)
+	fmt.Printf("%-15s | %-15s | %-20s | %-15T\n", "3.14", "untyped float", "float64", f)
+	fmt.Printf("%-15s | %-15s | %-20s | %-15T\n", "2i", "untyped complex", "complex128", c)
+	fmt.Printf("%-15s | %-15s | %-20s | %-15T\n", "\"Go\"", "untyped string", "string", s)
+
+	const Untyped = 10
+	var asFloat float64 = Untyped // fine: untyped 10 becomes float64
+	var asByte byte = Untyped     // also fine: it fits in a byte
+	fmt.Printf("const Untyped = 10 works as float64 (%v) and as byte (%v) without conversion.\n", asFloat, asByte)
+	fmt.Println("A typed const like `const Pi float64` would need float32(Pi) to become a float32.")
+
+	// --- 3.2 Compile-Time Arithmetic ---
+	// Constant expressions are evaluated exactly, with at least 256 bits of
+	// precision. Overflow only matters when the final value meets a type.
+	fmt.Printf("\n%-22s | %-18s | %-34s | %-10s\n", "Constant Expression", "Kind", "Exact Value (go/constant)", "At Run Time")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	// Variables, so the same expressions are computed with int at run time.
+	one, hundred, ninetyEight := 1, 100, 98
+	runtimeResults := map[string]string{
+		"1 << 100":              fmt.Sprint(one << hundred),
+		"1 << 100 >> 98":        fmt.Sprint(one << hundred >> ninetyEight),
+		"1.0 / 3":               fmt.Sprint(float64(one) / 3),
+		"'a' + 1":               fmt.Sprint('a' + rune(one)),
+		"1e300 * 1e300 / 1e300": fmt.Sprint(1e300 * float64(one) * 1e300 / 1e300),
+		"uint8(255) + 1":        fmt.Sprint(uint8(255) + uint8(one)),
+	}
+	for _, expr := range []string{"1 << 100", "1 << 100 >> 98", "1.0 / 3", "'a' + 1", "1e300 * 1e300 / 1e300", "uint8(255) + 1"} {
+		tv, err := types.Eval(token.NewFileSet(), nil, token.NoPos, expr)
+		if err != nil {
+			fmt.Printf("%-22s | \033[31m%-55s\033[0m | %-10s\n", expr, "compile error: "+strings.TrimPrefix(err.Error(), "eval:1:1: "), runtimeResults[expr])
+			continue
+		}
+		fmt.Printf("%-22s | %-18s | %-34s | %-10s\n", expr, tv.Type, exactString(tv.Value), runtimeResults[expr])
+	}
+	fmt.Println("`fmt.Println(1 << 100 >> 98)` compiles and prints 4; the same math on an int variable overflows to 0.")
+
+	// --- 3.3 iota Patterns ---
+	// iota counts lines inside a const block, starting at 0
+	fmt.Printf("\n\033[1;35m▶ IOTA PATTERNS (auto-incrementing constants)\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-20s | %-40s\n", "Pattern", "Values")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-20s | Read=%d Write=%d Execute=%d, Read|Execute=%d (%v)\n", "Bit flags", Read, Write, Execute, Read|Execute, Read|Execute)
+	fmt.Printf("%-20s | Low=%d High=%d Urgent=%d (0 and 2 skipped with _)\n", "Skipped values", Low, High, Urgent)
+	fmt.Printf("%-20s | KB=%d MB=%d GB=%d TB=%d\n", "KB / MB / GB", KB, MB, GB, TB)
+	fmt.Printf("%-20s | %d→%d, %d→%d, %d→%d\n", "Expression reuse", First, FirstSquared, Second, SecondSquared, Third, ThirdSquared)
+}
+
+// exactString prints a constant's exact value, shortening very long ones
+// and showing floats as a fraction when they aren't exact in decimal.
+func exactString(v constant.Value) string {
+	s := v.ExactString()
+	if v.Kind() == constant.Float {
+		if f := v.String(); f != s {
+			s = f + " (" + s + ")"
+		}
+	}
+	if len(s) > 34 {
+		s = s[:31] + "..."
+	}
+	return strings.TrimSpace(s)
+}
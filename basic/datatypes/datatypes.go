@@ -2,12 +2,20 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"runtime"
 	"unsafe"
 )
 
 func main() {
+	catalog := flag.Bool("catalog", false, "list every predeclared identifier from go/types.Universe and exit")
+	flag.Parse()
+	if *catalog {
+		printCatalog()
+		return
+	}
+
 	// Print system information
 	fmt.Printf("\n\033[1;36m╔════════════════════════════════════════════════════════════════╗\033[0m\n")
 	fmt.Printf("\033[1;36m║         GO DATA TYPES REFERENCE GUIDE (BEGINNER LEVEL)         ║\033[0m\n")
@@ -63,6 +71,10 @@ func main() {
 	fmt.Printf("%-15s | %-30s | %-15.2f | %-10d\n", "float32", "±1.4e-45 to ±3.4e+38", small_float32, unsafe.Sizeof(small_float32))
 	fmt.Printf("%-15s | %-30s | %-15.2e | %-10d\n", "float64", "±5.0e-324 to ±1.7e+308", medium_float64, unsafe.Sizeof(medium_float64))
 
+	// --- 2.1 Complex Numbers (see complex.go) ---
+	// Pairs of floats for math that needs the square root of -1
+	complexSection()
+
 	// --- 3. Constants ---
 	// Constants are immutable values that cannot be changed after declaration
 	// Use when: storing fixed values like Pi, configuration settings, etc.
@@ -76,6 +88,10 @@ func main() {
 	fmt.Printf("%-15s | %-15.8f | %-15s | %-10d\n", "Pi (float64)", Pi, "14 decimals", unsafe.Sizeof(Pi))
 	fmt.Printf("%-15s | %-15.5f | %-15s | %-10d\n", "E (float32)", E, "5 decimals", unsafe.Sizeof(E))
 
+	// --- 3.1 Untyped Constants and iota (see constants.go) ---
+	// Constants without a type are exact, and iota numbers them for you
+	untypedConstantsSection()
+
 	// --- 4. Booleans ---
 	// Boolean values are either true or false
 	// Use when: conditional logic, boolean flags, status checks, etc.
@@ -120,6 +136,10 @@ func main() {
 	fmt.Printf("%-15s | %-20s | %-15v | %-10d\n", "bool", "false", zeroBool, unsafe.Sizeof(zeroBool))
 	fmt.Printf("%-15s | %-20s | %-15s | %-10d\n", "string", "\"\" (empty)", "\""+zeroString+"\"", unsafe.Sizeof(zeroString))
 
+	// --- 6.1 Zero Values of Composite Types (see zerovalues.go) ---
+	// nil slices, maps, channels and funcs each fail in their own way
+	zeroValueExplorerSection()
+
 	// --- 7. nil and any ---
 	// 'any' is an alias for interface{} (available in Go 1.18+)
 	// nil represents "no value" for pointers, slices, maps, channels, etc.
@@ -159,6 +179,14 @@ func main() {
 	fmt.Printf("%-35s | %-30s\n", "multiple variables", "x, y := 1, 2  // Both same type")
 	fmt.Printf("%-35s | %-30s\n", "constant", "const Pi = 3.14  // Immutable")
 
+	// --- 10. Arbitrary Precision (see bignumbers.go) ---
+	// When uint64 or float64 aren't big or precise enough, math/big takes over
+	bigNumbersSection()
+
+	// --- 11. Money (see money.go) ---
+	// Floats can't represent most cents exactly, so money uses fixed-point decimals
+	moneySection()
+
 	fmt.Printf("\n\033[1;36m╔════════════════════════════════════════════════════════════════╗\033[0m\n")
 	fmt.Printf("\033[1;36m║                    Reference Guide Complete                    ║\033[0m\n")
 	fmt.Printf("\033[1;36m╚════════════════════════════════════════════════════════════════╝\033[0m\n\n")
@@ -0,0 +1,366 @@
+// Package decimal provides fixed-point decimal numbers and a currency-aware
+// Money type built on scaled int64 values. It backs the datatypes guide's
+// warning about floats and money: every amount is an exact count of minor
+// units (cents, pence, fils), every rounding step is explicit, and every
+// operation that could overflow an int64 reports it instead of wrapping.
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// MaxScale is the largest number of fractional digits a Decimal can carry.
+// 10^18 is the biggest power of ten that fits in an int64.
+const MaxScale = 18
+
+var (
+	// ErrOverflow is returned when a result does not fit in an int64.
+	ErrOverflow = errors.New("decimal: overflow")
+	// ErrSyntax is returned when a string is not a valid decimal number.
+	ErrSyntax = errors.New("decimal: invalid syntax")
+	// ErrCurrencyMismatch is returned when combining amounts in different currencies.
+	ErrCurrencyMismatch = errors.New("decimal: currency mismatch")
+	// ErrInvalidRatio is returned when Allocate gets ratios it cannot use.
+	ErrInvalidRatio = errors.New("decimal: invalid allocation ratios")
+)
+
+// RoundingMode decides what happens to a digit that no longer fits.
+type RoundingMode int
+
+const (
+	// HalfUp rounds ties away from zero: 2.345 → 2.35, -2.345 → -2.35.
+	// This is what most people learned at school.
+	HalfUp RoundingMode = iota
+	// HalfEven rounds ties to the nearest even digit: 2.345 → 2.34,
+	// 2.355 → 2.36. Also called banker's rounding, because it doesn't
+	// drift upward when you round lots of values.
+	HalfEven
+	// Down truncates toward zero: 2.349 → 2.34.
+	Down
+)
+
+// String returns the name of the rounding mode.
+func (m RoundingMode) String() string {
+	switch m {
+	case HalfUp:
+		return "HalfUp"
+	case HalfEven:
+		return "HalfEven"
+	case Down:
+		return "Down"
+	}
+	return "RoundingMode(" + strconv.Itoa(int(m)) + ")"
+}
+
+// pow10 holds 10^0 through 10^18.
+var pow10 = func() [MaxScale + 1]int64 {
+	var p [MaxScale + 1]int64
+	p[0] = 1
+	for i := 1; i < len(p); i++ {
+		p[i] = p[i-1] * 10
+	}
+	return p
+}()
+
+// Decimal is an exact decimal number stored as coef × 10^-scale.
+// The zero value is 0.
+type Decimal struct {
+	coef  int64
+	scale int
+}
+
+// New returns coef × 10^-scale, so New(1999, 2) is 19.99.
+func New(coef int64, scale int) Decimal {
+	if scale < 0 || scale > MaxScale {
+		panic("decimal: scale out of range")
+	}
+	return Decimal{coef: coef, scale: scale}
+}
+
+// Parse reads a decimal string such as "-1,234.5678". Commas are allowed as
+// thousands separators, but only between groups of exactly three digits,
+// so "1,2,3" is rejected; exponents are not allowed.
+func Parse(s string) (Decimal, error) {
+	orig := s
+	neg := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg, s = true, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	intPart, fracPart, hasDot := strings.Cut(s, ".")
+	if strings.Contains(intPart, ",") {
+		if !validGrouping(intPart) {
+			return Decimal{}, syntaxError(orig)
+		}
+		intPart = strings.ReplaceAll(intPart, ",", "")
+	}
+	if intPart == "" && fracPart == "" || hasDot && fracPart == "" {
+		return Decimal{}, syntaxError(orig)
+	}
+	if len(fracPart) > MaxScale {
+		return Decimal{}, syntaxError(orig)
+	}
+
+	var coef int64
+	for _, c := range intPart + fracPart {
+		if c < '0' || c > '9' {
+			return Decimal{}, syntaxError(orig)
+		}
+		var err error
+		if coef, err = mulInt(coef, 10); err != nil {
+			return Decimal{}, err
+		}
+		if coef, err = addInt(coef, int64(c-'0')); err != nil {
+			return Decimal{}, err
+		}
+	}
+	if neg {
+		coef = -coef
+	}
+	return Decimal{coef: coef, scale: len(fracPart)}, nil
+}
+
+// MustParse is like Parse but panics on error. It is meant for constants
+// in code, such as tax rates.
+func MustParse(s string) Decimal {
+	d, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// Coef returns the unscaled integer value.
+func (d Decimal) Coef() int64 { return d.coef }
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int { return d.scale }
+
+// Sign returns -1, 0 or +1.
+func (d Decimal) Sign() int {
+	switch {
+	case d.coef < 0:
+		return -1
+	case d.coef > 0:
+		return 1
+	}
+	return 0
+}
+
+// String formats d with exactly Scale digits after the point.
+func (d Decimal) String() string {
+	return format(d.coef, d.scale, false)
+}
+
+// Float64 converts d to the nearest float64. It exists for comparisons in
+// the guide; don't feed the result back into money math.
+func (d Decimal) Float64() float64 {
+	return float64(d.coef) / float64(pow10[d.scale])
+}
+
+// Rescale returns d with exactly scale fractional digits, rounding with
+// mode when digits have to be dropped.
+func (d Decimal) Rescale(scale int, mode RoundingMode) (Decimal, error) {
+	if scale < 0 || scale > MaxScale {
+		return Decimal{}, ErrOverflow
+	}
+	if scale >= d.scale {
+		coef, err := mulInt(d.coef, pow10[scale-d.scale])
+		if err != nil {
+			return Decimal{}, err
+		}
+		return Decimal{coef: coef, scale: scale}, nil
+	}
+	return Decimal{coef: divRound(d.coef, pow10[d.scale-scale], mode), scale: scale}, nil
+}
+
+// Add returns d + e at the larger of the two scales.
+func (d Decimal) Add(e Decimal) (Decimal, error) {
+	d, e, err := align(d, e)
+	if err != nil {
+		return Decimal{}, err
+	}
+	coef, err := addInt(d.coef, e.coef)
+	return Decimal{coef: coef, scale: d.scale}, err
+}
+
+// Sub returns d - e at the larger of the two scales.
+func (d Decimal) Sub(e Decimal) (Decimal, error) {
+	if e.coef == math.MinInt64 {
+		return Decimal{}, ErrOverflow
+	}
+	return d.Add(Decimal{coef: -e.coef, scale: e.scale})
+}
+
+// Mul returns d × e. The result's scale is the sum of both scales, so
+// callers usually Rescale it straight afterwards.
+func (d Decimal) Mul(e Decimal) (Decimal, error) {
+	if d.scale+e.scale > MaxScale {
+		return Decimal{}, ErrOverflow
+	}
+	coef, err := mulInt(d.coef, e.coef)
+	return Decimal{coef: coef, scale: d.scale + e.scale}, err
+}
+
+// Cmp compares d and e and returns -1, 0 or +1. Values that can't be
+// aligned without overflow are compared by sign and magnitude.
+func (d Decimal) Cmp(e Decimal) int {
+	if a, b, err := align(d, e); err == nil {
+		switch {
+		case a.coef < b.coef:
+			return -1
+		case a.coef > b.coef:
+			return 1
+		}
+		return 0
+	}
+	switch {
+	case d.Float64() < e.Float64():
+		return -1
+	case d.Float64() > e.Float64():
+		return 1
+	}
+	return 0
+}
+
+// align brings d and e to the same scale.
+func align(d, e Decimal) (Decimal, Decimal, error) {
+	var err error
+	switch {
+	case d.scale < e.scale:
+		d, err = d.Rescale(e.scale, Down)
+	case e.scale < d.scale:
+		e, err = e.Rescale(d.scale, Down)
+	}
+	return d, e, err
+}
+
+// addInt adds two int64 values, reporting ErrOverflow instead of wrapping.
+func addInt(a, b int64) (int64, error) {
+	sum := a + b
+	// Overflow happened if both operands share a sign the result doesn't.
+	if (a >= 0) == (b >= 0) && (sum >= 0) != (a >= 0) {
+		return 0, ErrOverflow
+	}
+	return sum, nil
+}
+
+// mulInt multiplies two int64 values, reporting ErrOverflow instead of wrapping.
+func mulInt(a, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	neg := (a < 0) != (b < 0)
+	hi, lo := bits.Mul64(absU(a), absU(b))
+	limit := uint64(math.MaxInt64)
+	if neg {
+		limit++ // |MinInt64| is one more than MaxInt64
+	}
+	if hi != 0 || lo > limit {
+		return 0, ErrOverflow
+	}
+	if neg {
+		return int64(-lo), nil
+	}
+	return int64(lo), nil
+}
+
+// absU returns |a| as a uint64, which also works for math.MinInt64.
+func absU(a int64) uint64 {
+	if a < 0 {
+		return uint64(-a)
+	}
+	return uint64(a)
+}
+
+// divRound returns n / d rounded with mode. d must be positive.
+func divRound(n, d int64, mode RoundingMode) int64 {
+	q, r := n/d, n%d
+	if r == 0 || mode == Down {
+		return q
+	}
+	twice, divisor := absU(r)*2, uint64(d)
+	roundAway := false
+	switch mode {
+	case HalfUp:
+		roundAway = twice >= divisor
+	case HalfEven:
+		roundAway = twice > divisor || twice == divisor && q%2 != 0
+	}
+	if !roundAway {
+		return q
+	}
+	if n < 0 {
+		return q - 1
+	}
+	return q + 1
+}
+
+// format renders coef × 10^-scale, optionally with thousands separators.
+func format(coef int64, scale int, grouped bool) string {
+	digits := strconv.FormatUint(absU(coef), 10)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	intPart, fracPart := digits[:len(digits)-scale], digits[len(digits)-scale:]
+	if grouped {
+		intPart = group(intPart)
+	}
+
+	var b strings.Builder
+	if coef < 0 {
+		b.WriteByte('-')
+	}
+	b.WriteString(intPart)
+	if scale > 0 {
+		b.WriteByte('.')
+		b.WriteString(fracPart)
+	}
+	return b.String()
+}
+
+// group inserts a comma every three digits from the right.
+func group(digits string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+	var b strings.Builder
+	lead := len(digits) % 3
+	if lead > 0 {
+		b.WriteString(digits[:lead])
+	}
+	for i := lead; i < len(digits); i += 3 {
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
+
+// validGrouping reports whether comma-separated digits form proper
+// thousands groups: a lead group of one to three digits, then groups of
+// exactly three.
+func validGrouping(intPart string) bool {
+	groups := strings.Split(intPart, ",")
+	if len(groups[0]) < 1 || len(groups[0]) > 3 {
+		return false
+	}
+	for _, g := range groups[1:] {
+		if len(g) != 3 {
+			return false
+		}
+	}
+	return true
+}
+
+func syntaxError(s string) error {
+	return fmt.Errorf("%w: %q", ErrSyntax, s)
+}
@@ -0,0 +1,247 @@
+package decimal
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestRescaleRoundingModes(t *testing.T) {
+	tests := []struct {
+		in   string
+		mode RoundingMode
+		want string
+	}{
+		{"2.345", HalfUp, "2.35"},
+		{"2.345", HalfEven, "2.34"},
+		{"2.345", Down, "2.34"},
+		{"2.355", HalfEven, "2.36"},
+		{"2.349", HalfUp, "2.35"},
+		{"2.349", Down, "2.34"},
+		{"2.344", HalfUp, "2.34"},
+		{"-2.345", HalfUp, "-2.35"},
+		{"-2.345", HalfEven, "-2.34"},
+		{"-2.345", Down, "-2.34"},
+		{"-2.355", HalfEven, "-2.36"},
+		{"0.005", HalfUp, "0.01"},
+		{"0.005", HalfEven, "0.00"},
+		{"1.5", HalfEven, "1.50"}, // scaling up never rounds
+	}
+	for _, tt := range tests {
+		got, err := MustParse(tt.in).Rescale(2, tt.mode)
+		if err != nil {
+			t.Errorf("Rescale(%s, 2, %v): %v", tt.in, tt.mode, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("Rescale(%s, 2, %v) = %s, want %s", tt.in, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestHalfEvenToInteger(t *testing.T) {
+	for in, want := range map[string]string{"0.5": "0", "1.5": "2", "2.5": "2", "3.5": "4", "-2.5": "-2"} {
+		got, err := MustParse(in).Rescale(0, HalfEven)
+		if err != nil || got.String() != want {
+			t.Errorf("Rescale(%s, 0, HalfEven) = %s, %v; want %s", in, got, err, want)
+		}
+	}
+}
+
+func TestAllocateSumsToOriginal(t *testing.T) {
+	tests := []struct {
+		units  int64
+		ratios []int
+	}{
+		{10000, []int{1, 1, 1}},
+		{5, []int{70, 30}},
+		{1, []int{1, 1, 1}},
+		{-10000, []int{1, 1, 1}},
+		{-7, []int{3, 0, 2}},
+		{0, []int{1, 2}},
+		{999, []int{0, 1, 0}},
+		{math.MaxInt64, []int{1, 1, 1}},
+		{math.MaxInt64, []int{3, 1}},
+		{math.MinInt64, []int{1}},
+		{math.MinInt64, []int{2, 3, 5}},
+		{math.MaxInt64, []int{math.MaxInt32, math.MaxInt32 - 1}},
+	}
+	for _, tt := range tests {
+		shares, err := NewMoney(tt.units, USD).Allocate(tt.ratios...)
+		if err != nil {
+			t.Errorf("Allocate(%d, %v): %v", tt.units, tt.ratios, err)
+			continue
+		}
+		var sum int64
+		for i, s := range shares {
+			sum += s.Units()
+			if tt.ratios[i] == 0 && s.Units() != 0 {
+				t.Errorf("Allocate(%d, %v): share %d has ratio 0 but got %d", tt.units, tt.ratios, i, s.Units())
+			}
+			if s.Currency() != USD {
+				t.Errorf("Allocate(%d, %v): share %d lost its currency", tt.units, tt.ratios, i)
+			}
+		}
+		if sum != tt.units {
+			t.Errorf("Allocate(%d, %v) = %v, sums to %d", tt.units, tt.ratios, shares, sum)
+		}
+	}
+}
+
+func TestAllocateHandsOutLeftoversFirst(t *testing.T) {
+	shares, err := NewMoney(10000, USD).Split(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int64{3334, 3333, 3333}
+	for i, s := range shares {
+		if s.Units() != want[i] {
+			t.Errorf("Split(3) of $100 share %d = %d, want %d", i, s.Units(), want[i])
+		}
+	}
+}
+
+func TestAllocateInvalidRatios(t *testing.T) {
+	for _, ratios := range [][]int{nil, {0, 0}, {1, -1}, {math.MaxInt, math.MaxInt, 2}} {
+		if _, err := NewMoney(100, USD).Allocate(ratios...); !errors.Is(err, ErrInvalidRatio) {
+			t.Errorf("Allocate(%v) error = %v, want ErrInvalidRatio", ratios, err)
+		}
+	}
+	if _, err := NewMoney(100, USD).Split(0); !errors.Is(err, ErrInvalidRatio) {
+		t.Errorf("Split(0) error = %v, want ErrInvalidRatio", err)
+	}
+}
+
+func TestParseFormatRoundTrip(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"0", "0"},
+		{"0.00", "0.00"},
+		{"-1.5", "-1.5"},
+		{"+3", "3"},
+		{".5", "0.5"},
+		{"-0.001", "-0.001"},
+		{"123.456", "123.456"},
+		{"1,234.5", "1234.5"},
+		{"12,345,678", "12345678"},
+		{"9223372036854775807", "9223372036854775807"},
+		{"0.000000000000000001", "0.000000000000000001"},
+	}
+	for _, tt := range tests {
+		d, err := Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.in, err)
+			continue
+		}
+		if d.String() != tt.want {
+			t.Errorf("Parse(%q).String() = %q, want %q", tt.in, d.String(), tt.want)
+		}
+		again, err := Parse(d.String())
+		if err != nil || again != d {
+			t.Errorf("Parse(%q) round trip = %v, %v; want %v", d.String(), again, err, d)
+		}
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	for _, in := range []string{
+		"", "-", ".", "1.", "abc", "1e5", "--1", "1.2.3", " 1",
+		"1,2,3", "12,34", ",123", "1,234,56", "1234,567,", "1,,234", "1,234.5,6",
+		"0.0000000000000000001", // 19 fractional digits
+	} {
+		if _, err := Parse(in); !errors.Is(err, ErrSyntax) {
+			t.Errorf("Parse(%q) error = %v, want ErrSyntax", in, err)
+		}
+	}
+}
+
+func TestMoneyStringRoundTrip(t *testing.T) {
+	tests := []struct {
+		units int64
+		c     Currency
+		want  string
+	}{
+		{123456, USD, "USD 1,234.56"},
+		{-5, EUR, "EUR -0.05"},
+		{1234567, JPY, "JPY 1,234,567"},
+		{1234567, KWD, "KWD 1,234.567"},
+		{0, GBP, "GBP 0.00"},
+	}
+	for _, tt := range tests {
+		m := NewMoney(tt.units, tt.c)
+		if m.String() != tt.want {
+			t.Errorf("NewMoney(%d, %s).String() = %q, want %q", tt.units, tt.c.Code, m.String(), tt.want)
+		}
+		back, err := ParseMoney(m.String(), tt.c, Down)
+		if err != nil || back != m {
+			t.Errorf("ParseMoney(%q) = %v, %v; want %v", m.String(), back, err, m)
+		}
+	}
+}
+
+func TestParseMoneyRounds(t *testing.T) {
+	m, err := ParseMoney("1,234.565", USD, HalfEven)
+	if err != nil || m.Units() != 123456 {
+		t.Errorf("ParseMoney(1,234.565, HalfEven) = %v, %v; want 123456 units", m.Units(), err)
+	}
+	if _, err := ParseMoney("EUR 1.00", USD, HalfUp); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("ParseMoney(EUR into USD) error = %v, want ErrCurrencyMismatch", err)
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	maxD, minD := New(math.MaxInt64, 0), New(math.MinInt64, 0)
+	one := New(1, 0)
+	checks := []struct {
+		name string
+		err  error
+	}{
+		{"Parse(MaxInt64+1)", second(Parse("9223372036854775808"))},
+		{"Max.Add(1)", second(maxD.Add(one))},
+		{"Min.Sub(1)", second(minD.Sub(one))},
+		{"0.Sub(Min)", second(New(0, 0).Sub(minD))},
+		{"Max.Mul(2)", second(maxD.Mul(New(2, 0)))},
+		{"Min.Mul(-1)", second(minD.Mul(New(-1, 0)))},
+		{"Max.Rescale(1)", second(maxD.Rescale(1, Down))},
+		{"scale sum over MaxScale", second(New(1, 10).Mul(New(1, 9)))},
+		{"Money Max.Add(1)", second(NewMoney(math.MaxInt64, USD).Add(NewMoney(1, USD)))},
+		{"Money 0.Sub(Min)", second(NewMoney(0, USD).Sub(NewMoney(math.MinInt64, USD)))},
+	}
+	for _, c := range checks {
+		if !errors.Is(c.err, ErrOverflow) {
+			t.Errorf("%s error = %v, want ErrOverflow", c.name, c.err)
+		}
+	}
+
+	// The edges themselves must still work.
+	if got, err := New(math.MaxInt64-1, 0).Add(one); err != nil || got.Coef() != math.MaxInt64 {
+		t.Errorf("(Max-1).Add(1) = %v, %v", got, err)
+	}
+	if got, err := minD.Mul(one); err != nil || got.Coef() != math.MinInt64 {
+		t.Errorf("Min.Mul(1) = %v, %v", got, err)
+	}
+	if got, err := New(math.MinInt64/2, 0).Mul(New(2, 0)); err != nil || got.Coef() != math.MinInt64 {
+		t.Errorf("(Min/2).Mul(2) = %v, %v", got, err)
+	}
+}
+
+func TestCmp(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.50", "1.5", 0},
+		{"1.49", "1.5", -1},
+		{"-1", "-2", 1},
+		{"9223372036854775807", "0.5", 1}, // can't be aligned; falls back to magnitude
+	}
+	for _, tt := range tests {
+		if got := MustParse(tt.a).Cmp(MustParse(tt.b)); got != tt.want {
+			t.Errorf("Cmp(%s, %s) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+// second drops the value from a (value, error) pair.
+func second[T any](_ T, err error) error { return err }
@@ -0,0 +1,182 @@
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"strings"
+)
+
+// Currency describes how many minor-unit digits an amount carries.
+type Currency struct {
+	Code   string // ISO 4217 code, e.g. "USD"
+	Digits int    // digits after the decimal point, e.g. 2 for cents
+}
+
+// Common currencies. JPY has no minor unit and KWD has three.
+var (
+	USD = Currency{Code: "USD", Digits: 2}
+	EUR = Currency{Code: "EUR", Digits: 2}
+	GBP = Currency{Code: "GBP", Digits: 2}
+	JPY = Currency{Code: "JPY", Digits: 0}
+	KWD = Currency{Code: "KWD", Digits: 3}
+)
+
+// Money is an exact amount of a currency, stored as an int64 count of
+// minor units. The zero value is not usable because it has no currency;
+// create values with NewMoney, FromDecimal or ParseMoney.
+type Money struct {
+	units    int64
+	currency Currency
+}
+
+// NewMoney returns units minor units of c, so NewMoney(1999, USD) is $19.99.
+func NewMoney(units int64, c Currency) Money {
+	return Money{units: units, currency: c}
+}
+
+// FromDecimal converts d to c's precision, rounding with mode.
+func FromDecimal(d Decimal, c Currency, mode RoundingMode) (Money, error) {
+	r, err := d.Rescale(c.Digits, mode)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{units: r.coef, currency: c}, nil
+}
+
+// ParseMoney reads an amount such as "1,234.565" or "USD 1234.56" and
+// rounds it to c's precision with mode. A currency code, if present,
+// must match c.
+func ParseMoney(s string, c Currency, mode RoundingMode) (Money, error) {
+	s = strings.TrimSpace(s)
+	if code, rest, ok := strings.Cut(s, " "); ok {
+		if code != c.Code {
+			return Money{}, fmt.Errorf("%w: %q is not %s", ErrCurrencyMismatch, code, c.Code)
+		}
+		s = strings.TrimSpace(rest)
+	}
+	d, err := Parse(s)
+	if err != nil {
+		return Money{}, err
+	}
+	return FromDecimal(d, c, mode)
+}
+
+// Units returns the amount in minor units.
+func (m Money) Units() int64 { return m.units }
+
+// Currency returns the currency of m.
+func (m Money) Currency() Currency { return m.currency }
+
+// Decimal returns m as a Decimal with the currency's scale.
+func (m Money) Decimal() Decimal { return Decimal{coef: m.units, scale: m.currency.Digits} }
+
+// IsZero reports whether m is zero.
+func (m Money) IsZero() bool { return m.units == 0 }
+
+// String formats m as "USD 1,234.56".
+func (m Money) String() string {
+	return m.currency.Code + " " + format(m.units, m.currency.Digits, true)
+}
+
+// Add returns m + o. Both amounts must share a currency.
+func (m Money) Add(o Money) (Money, error) {
+	if m.currency != o.currency {
+		return Money{}, mismatch(m, o)
+	}
+	units, err := addInt(m.units, o.units)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{units: units, currency: m.currency}, nil
+}
+
+// Sub returns m - o. Both amounts must share a currency.
+func (m Money) Sub(o Money) (Money, error) {
+	if m.currency != o.currency {
+		return Money{}, mismatch(m, o)
+	}
+	if o.units == math.MinInt64 {
+		return Money{}, ErrOverflow
+	}
+	return m.Add(Money{units: -o.units, currency: o.currency})
+}
+
+// Mul returns m × factor, rounded back to the currency's precision with
+// mode. Use it for quantities, tax rates and exchange rates.
+func (m Money) Mul(factor Decimal, mode RoundingMode) (Money, error) {
+	product, err := m.Decimal().Mul(factor)
+	if err != nil {
+		return Money{}, err
+	}
+	return FromDecimal(product, m.currency, mode)
+}
+
+// Allocate splits m in proportion to ratios without creating or losing a
+// single minor unit. Leftover units from rounding down are handed out one
+// at a time, starting with the first share, so Allocate(1, 1, 1) of $100
+// gives $33.34, $33.33 and $33.33.
+func (m Money) Allocate(ratios ...int) ([]Money, error) {
+	if len(ratios) == 0 {
+		return nil, ErrInvalidRatio
+	}
+	var total uint64
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, ErrInvalidRatio
+		}
+		sum, carry := bits.Add64(total, uint64(r), 0)
+		if carry != 0 {
+			return nil, ErrInvalidRatio
+		}
+		total = sum
+	}
+	if total == 0 {
+		return nil, ErrInvalidRatio
+	}
+
+	shares := make([]Money, len(ratios))
+	remainder := m.units
+	for i, r := range ratios {
+		// |units|×r can need 128 bits even though the share itself fits.
+		// Since r <= total, the high word is below total and Div64 can't
+		// overflow, and the quotient is at most |units|.
+		hi, lo := bits.Mul64(absU(m.units), uint64(r))
+		q, _ := bits.Div64(hi, lo, total)
+		units := int64(q)
+		if m.units < 0 {
+			units = -units
+		}
+		shares[i] = Money{units: units, currency: m.currency}
+		remainder -= units
+	}
+
+	step := int64(1)
+	if remainder < 0 {
+		step = -1
+	}
+	for i := 0; remainder != 0; i = (i + 1) % len(shares) {
+		if ratios[i] == 0 {
+			continue
+		}
+		shares[i].units += step
+		remainder -= step
+	}
+	return shares, nil
+}
+
+// Split divides m into n equal parts using Allocate.
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, ErrInvalidRatio
+	}
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
+
+func mismatch(m, o Money) error {
+	return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency.Code, o.currency.Code)
+}
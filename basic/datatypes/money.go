@@ -0,0 +1,102 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"golang/datatypes/decimal"
+)
+
+// moneySection backs up the readme's "don't use floats for money" warning
+// by running the same bookkeeping through float32, float64 and the
+// fixed-point decimal package side by side.
+func moneySection() {
+	// --- 11. Floats vs Fixed-Point Money ---
+	// decimal.Money stores whole cents in an int64, so adding never drifts
+	fmt.Printf("\n\033[1;31m▶ MONEY: FLOATS VS FIXED-POINT DECIMAL (golang/datatypes/decimal)\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-28s | %-18s | %-18s | %-18s\n", "Scenario", "float32", "float64", "decimal.Money")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+
+	// Add ten cents a million times. The right answer is exactly 100,000.00.
+	var dimes32 float32
+	var dimes64 float64
+	dimes := decimal.NewMoney(0, decimal.USD)
+	tenCents := decimal.NewMoney(10, decimal.USD)
+	for i := 0; i < 1_000_000; i++ {
+		dimes32 += 0.10
+		dimes64 += 0.10
+		dimes, _ = dimes.Add(tenCents)
+	}
+	fmt.Printf("%-28s | %-18.2f | %-18.8f | %-18s\n", "$0.10 added 1,000,000 times", dimes32, dimes64, dimes)
+
+	// A small shop ledger: sales, refunds, and 8.25% tax rounded per line.
+	taxRate := decimal.MustParse("0.0825")
+	var ledger32 float32
+	var ledger64 float64
+	ledger := decimal.NewMoney(0, decimal.USD)
+	for i := 1; i <= 10_000; i++ {
+		price := decimal.NewMoney(1999, decimal.USD) // $19.99 sale
+		if i%7 == 0 {
+			price = decimal.NewMoney(-437, decimal.USD) // $4.37 refund
+		}
+		tax, _ := price.Mul(taxRate, decimal.HalfEven)
+		line, _ := price.Add(tax)
+		ledger, _ = ledger.Add(line)
+
+		p64 := price.Decimal().Float64()
+		ledger64 += p64 + math.RoundToEven(p64*0.0825*100)/100
+		p32 := float32(p64)
+		ledger32 += p32 + float32(math.RoundToEven(float64(p32*0.0825*100)))/100
+	}
+	fmt.Printf("%-28s | %-18.2f | %-18.8f | %-18s\n", "10,000 taxed transactions", ledger32, ledger64, ledger)
+	fmt.Println("Floats drift a little on every operation; the decimal total is exact to the cent.")
+
+	// --- 11.1 Rounding Modes ---
+	fmt.Printf("\n%-12s | %-22s | %-16s | %-16s\n", "Amount", "float64 math.Round", "HalfUp", "HalfEven (banker's)")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	for _, s := range []string{"2.345", "2.355", "-2.345", "0.125", "1.005"} {
+		f := decimal.MustParse(s).Float64()
+		up, _ := decimal.ParseMoney(s, decimal.USD, decimal.HalfUp)
+		even, _ := decimal.ParseMoney(s, decimal.USD, decimal.HalfEven)
+		fmt.Printf("%-12s | %-22.2f | %-16s | %-16s\n", s, math.Round(f*100)/100, up, even)
+	}
+	fmt.Println("1.005 is really 1.00499999999999989... as a float64, so float rounding goes the wrong way.")
+
+	// --- 11.2 Allocation ---
+	fmt.Printf("\n%-28s | %-46s\n", "Allocation", "Result")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	hundred := decimal.NewMoney(10000, decimal.USD)
+	third := float64(100) / 3
+	fmt.Printf("%-28s | %.2f × 3 = %.2f (a cent vanished)\n", "float64: $100 / 3", third, math.Round(third*100)/100*3)
+	parts, _ := hundred.Split(3)
+	fmt.Printf("%-28s | %v\n", "Money.Split(3) of $100", parts)
+	nickel := decimal.NewMoney(5, decimal.USD)
+	shares, _ := nickel.Allocate(70, 30)
+	fmt.Printf("%-28s | %v\n", "Money.Allocate(70, 30) of 5¢", shares)
+
+	// --- 11.3 Currency-Aware Precision ---
+	fmt.Printf("\n%-28s | %-14s | %-20s\n", "ParseMoney(\"1234.5678\", c)", "Minor Digits", "Result")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	for _, c := range []decimal.Currency{decimal.USD, decimal.JPY, decimal.KWD} {
+		m, _ := decimal.ParseMoney("1234.5678", c, decimal.HalfUp)
+		fmt.Printf("%-28s | %-14d | %-20s\n", c.Code, c.Digits, m)
+	}
+
+	// --- 11.4 Errors Instead of Silent Garbage ---
+	fmt.Printf("\n%-40s | %-40s\n", "Operation", "Result")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	var wrapped int64 = math.MaxInt64
+	wrapped++
+	fmt.Printf("%-40s | %d (wrapped silently)\n", "int64: MaxInt64 + 1", wrapped)
+	richest := decimal.NewMoney(math.MaxInt64, decimal.USD)
+	_, err := richest.Add(decimal.NewMoney(1, decimal.USD))
+	fmt.Printf("%-40s | %v (errors.Is ErrOverflow = %v)\n", "Money: MaxInt64 cents + 1 cent", err, errors.Is(err, decimal.ErrOverflow))
+	_, err = decimal.NewMoney(100, decimal.USD).Add(decimal.NewMoney(100, decimal.EUR))
+	fmt.Printf("%-40s | %v\n", "Money: USD 1.00 + EUR 1.00", err)
+	_, err = decimal.ParseMoney("12.3.4", decimal.USD, decimal.HalfUp)
+	fmt.Printf("%-40s | %v\n", "ParseMoney(\"12.3.4\")", err)
+	_, err = decimal.ParseMoney("99999999999999999999", decimal.USD, decimal.HalfUp)
+	fmt.Printf("%-40s | %v\n", "ParseMoney(\"99999999999999999999\")", err)
+}
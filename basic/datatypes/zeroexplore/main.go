@@ -0,0 +1,80 @@
+// Command zeroexplore prints the zero value of Go types: what it looks like,
+// whether it can be used without initializing it, and whether it supports ==.
+// Types can be written as expressions, or loaded by name from any package
+// directory, including types declared inside functions.
+//
+// Usage:
+//
+//	go run ./zeroexplore
+//	go run ./zeroexplore "map[string][]int" "chan<- error" "[2]func()"
+//	go run ./zeroexplore -dir ../datastructures Person Address
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/token"
+	"go/types"
+	"os"
+	"sort"
+	"strings"
+
+	"golang/datatypes/zerovalue"
+)
+
+var defaultExprs = []string{
+	"int", "string", "[]int", "map[string]int", "chan int", "func()", "*int", "error",
+	"struct{ Name string; Tags []string; Meta map[string]any }",
+}
+
+func main() {
+	dir := flag.String("dir", "", "package directory to load named types from (e.g. ../datastructures)")
+	flag.Parse()
+
+	fmt.Printf("\n\033[1;36m╔════════════════════════════════════════════════════════════════╗\033[0m\n")
+	fmt.Printf("\033[1;36m║                      ZERO VALUE EXPLORER                       ║\033[0m\n")
+	fmt.Printf("\033[1;36m╚════════════════════════════════════════════════════════════════╝\033[0m\n")
+
+	var loaded map[string]types.Type
+	if *dir != "" {
+		var err error
+		if loaded, err = zerovalue.LoadTypes(*dir); err != nil {
+			fmt.Fprintf(os.Stderr, "\033[1;31m✗ %v\033[0m\n", err)
+			os.Exit(1)
+		}
+		names := make([]string, 0, len(loaded))
+		for name := range loaded {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Printf("Types in %s: %s\n", *dir, strings.Join(names, ", "))
+	}
+
+	args := flag.Args()
+	if len(args) == 0 && *dir == "" {
+		args = defaultExprs
+	}
+
+	failed := false
+	for _, arg := range args {
+		t, ok := loaded[arg]
+		if !ok {
+			// Not a loaded name: treat it as a type expression over the
+			// predeclared identifiers (int, error, any, ...).
+			tv, err := types.Eval(token.NewFileSet(), nil, token.NoPos, arg)
+			if err != nil || !tv.IsType() {
+				fmt.Fprintf(os.Stderr, "\033[1;31m✗ %q is not a type we know (use -dir to load package types)\033[0m\n", arg)
+				failed = true
+				continue
+			}
+			t = tv.Type
+		}
+		fmt.Printf("\n\033[1;33m▶ %s\033[0m\n", arg)
+		fmt.Printf("\033[90m%s\033[0m\n", strings.Repeat("─", 77))
+		zerovalue.Print(os.Stdout, zerovalue.ExploreTypes(t))
+	}
+	fmt.Println()
+	if failed {
+		os.Exit(1)
+	}
+}
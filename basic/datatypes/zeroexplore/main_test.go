@@ -0,0 +1,26 @@
+package main
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// TestDocumentedCommand runs the command the guide and the readme tell
+// readers to try, from the directory they'd run it in.
+func TestDocumentedCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds and runs zeroexplore")
+	}
+	cmd := exec.Command("go", "run", "./zeroexplore", "-dir", "../datastructures", "Person", "Address")
+	cmd.Dir = ".."
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("go run ./zeroexplore -dir ../datastructures Person Address: %v\n%s", err, out)
+	}
+	for _, want := range []string{"▶ Person", "▶ Address", "Zip int"} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("output is missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package zerovalue
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Explore reports on the zero value of t. Slices, maps, channels and funcs
+// are also tested for real: their zero value is created with reflect.Zero
+// and the risky operations are run under recover, replacing the predicted
+// notes with what actually happened.
+func Explore(t reflect.Type) Report {
+	return explore("", reflectShape{t})
+}
+
+// Of is Explore for the type parameter, so Of[map[string]int]() reads like
+// a declaration.
+func Of[T any]() Report {
+	return Explore(reflect.TypeFor[T]())
+}
+
+type reflectShape struct{ t reflect.Type }
+
+func (s reflectShape) String() string     { return s.t.String() }
+func (s reflectShape) Kind() reflect.Kind { return s.t.Kind() }
+func (s reflectShape) NumField() int      { return s.t.NumField() }
+func (s reflectShape) Elem() shape        { return reflectShape{s.t.Elem()} }
+func (s reflectShape) Len() int           { return s.t.Len() }
+func (s reflectShape) Comparable() bool   { return s.t.Comparable() }
+
+func (s reflectShape) Field(i int) (string, shape) {
+	f := s.t.Field(i)
+	return f.Name, reflectShape{f.Type}
+}
+
+func (s reflectShape) probe() []string {
+	zero := reflect.Zero(s.t)
+	switch s.t.Kind() {
+	case reflect.Slice:
+		return []string{
+			"tried: len(s), cap(s) → " + try(func() string { return fmt.Sprintf("%d, %d", zero.Len(), zero.Cap()) }),
+			"tried: append(s, zero) → " + try(func() string {
+				return fmt.Sprintf("len %d", reflect.Append(zero, reflect.Zero(s.t.Elem())).Len())
+			}),
+			"tried: s[0] → " + try(func() string { return fmt.Sprint(zero.Index(0)) }),
+		}
+	case reflect.Map:
+		key, elem := reflect.Zero(s.t.Key()), reflect.Zero(s.t.Elem())
+		return []string{
+			"tried: v, ok := m[k] → " + try(func() string {
+				return fmt.Sprintf("zero value, ok=%v", zero.MapIndex(key).IsValid())
+			}),
+			"tried: delete(m, k) → " + try(func() string { zero.SetMapIndex(key, reflect.Value{}); return "no-op" }),
+			"tried: m[k] = v → " + try(func() string { zero.SetMapIndex(key, elem); return "stored" }),
+		}
+	case reflect.Chan:
+		var seen []string
+		if s.t.ChanDir()&reflect.SendDir != 0 {
+			seen = append(seen, "tried: ch <- v → "+wouldBlock(reflect.SelectCase{Dir: reflect.SelectSend, Chan: zero, Send: reflect.Zero(s.t.Elem())}))
+		}
+		if s.t.ChanDir()&reflect.RecvDir != 0 {
+			seen = append(seen, "tried: <-ch → "+wouldBlock(reflect.SelectCase{Dir: reflect.SelectRecv, Chan: zero}))
+		}
+		return append(seen, "tried: close(ch) → "+try(func() string { zero.Close(); return "closed" }))
+	case reflect.Func:
+		args := make([]reflect.Value, s.t.NumIn())
+		for i := range args {
+			args[i] = reflect.Zero(s.t.In(i))
+		}
+		return []string{"tried: f(...) → " + try(func() string {
+			if s.t.IsVariadic() {
+				zero.CallSlice(args)
+			} else {
+				zero.Call(args)
+			}
+			return "returned"
+		})}
+	}
+	return nil
+}
+
+// try runs op and turns a panic into a readable result instead of a crash.
+func try(op func() string) (result string) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = "\033[31mpanic: " + strings.TrimPrefix(fmt.Sprint(r), "runtime error: ") + "\033[0m"
+		}
+	}()
+	return "ok, " + op()
+}
+
+// wouldBlock checks a channel operation with a select default case, which
+// is taken only when the operation cannot proceed right now. For a nil
+// channel that means never, so this is as close as we can get to showing
+// "blocks forever" without actually blocking.
+func wouldBlock(c reflect.SelectCase) string {
+	chosen, _, _ := reflect.Select([]reflect.SelectCase{c, {Dir: reflect.SelectDefault}})
+	if chosen == 1 {
+		return "\033[31mblocks (select took the default case)\033[0m"
+	}
+	return "ok, proceeded"
+}
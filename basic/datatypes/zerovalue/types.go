@@ -0,0 +1,208 @@
+package zerovalue
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ExploreTypes reports on the zero value of a type found by the type
+// checker. There is no running value to poke at, so the report comes from
+// the rules alone.
+func ExploreTypes(t types.Type) Report {
+	return explore("", typesShape{t})
+}
+
+// LoadTypes type-checks the Go package in dir and returns every named type
+// declared in it, including types declared inside functions (like a struct
+// defined in main). When two scopes declare the same name, the outermost
+// one wins. Imports are resolved from dir's own module, so dir can belong
+// to a different module than the caller.
+func LoadTypes(dir string) (map[string]types.Type, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	pkgs := map[string][]*ast.File{}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") || strings.HasSuffix(e.Name(), "_test.go") {
+			continue
+		}
+		if ok, _ := build.Default.MatchFile(dir, e.Name()); !ok {
+			continue // excluded by build tags or GOOS/GOARCH suffix
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, e.Name()), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		pkgs[f.Name.Name] = append(pkgs[f.Name.Name], f)
+	}
+	if len(pkgs) == 0 {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+
+	imp, err := moduleImporter(fset, dir, pkgs)
+	if err != nil {
+		return nil, err
+	}
+	found := map[string]types.Type{}
+	for name, files := range pkgs {
+		conf := types.Config{Importer: imp}
+		checked, err := conf.Check(name, fset, files, nil)
+		if err != nil {
+			return nil, fmt.Errorf("type-checking %s: %w", dir, err)
+		}
+		collectTypes(checked.Scope(), found)
+	}
+	return found, nil
+}
+
+// moduleImporter returns an importer that reads compiled export data for
+// everything the files import. The list comes from "go list" run inside dir,
+// so import paths resolve against dir's go.mod rather than the caller's.
+func moduleImporter(fset *token.FileSet, dir string, pkgs map[string][]*ast.File) (types.Importer, error) {
+	seen := map[string]bool{}
+	for _, files := range pkgs {
+		for _, f := range files {
+			for _, spec := range f.Imports {
+				if path, err := strconv.Unquote(spec.Path.Value); err == nil && path != "C" && path != "unsafe" {
+					seen[path] = true
+				}
+			}
+		}
+	}
+	exports := map[string]string{}
+	if len(seen) > 0 {
+		args := []string{"list", "-export", "-deps", "-f", "{{.ImportPath}}={{.Export}}"}
+		cmd := exec.Command("go", append(args, slices.Sorted(maps.Keys(seen))...)...)
+		cmd.Dir = dir
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("listing imports of %s: %v: %s", dir, err, strings.TrimSpace(stderr.String()))
+		}
+		for line := range strings.Lines(string(out)) {
+			if path, file, ok := strings.Cut(strings.TrimSpace(line), "="); ok && file != "" {
+				exports[path] = file
+			}
+		}
+	}
+	return importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+		file, ok := exports[path]
+		if !ok {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(file)
+	}), nil
+}
+
+// collectTypes walks a scope and all the scopes nested inside it.
+func collectTypes(scope *types.Scope, found map[string]types.Type) {
+	for _, name := range scope.Names() {
+		if tn, ok := scope.Lookup(name).(*types.TypeName); ok {
+			if _, dup := found[name]; !dup {
+				found[name] = tn.Type()
+			}
+		}
+	}
+	for i := 0; i < scope.NumChildren(); i++ {
+		collectTypes(scope.Child(i), found)
+	}
+}
+
+type typesShape struct{ t types.Type }
+
+// String drops package paths so "main.Person" reads as "Person".
+func (s typesShape) String() string {
+	return types.TypeString(s.t, func(*types.Package) string { return "" })
+}
+
+func (s typesShape) Kind() reflect.Kind {
+	switch u := s.t.Underlying().(type) {
+	case *types.Basic:
+		return basicKinds[u.Kind()]
+	case *types.Pointer:
+		return reflect.Pointer
+	case *types.Slice:
+		return reflect.Slice
+	case *types.Array:
+		return reflect.Array
+	case *types.Map:
+		return reflect.Map
+	case *types.Chan:
+		return reflect.Chan
+	case *types.Signature:
+		return reflect.Func
+	case *types.Interface:
+		return reflect.Interface
+	case *types.Struct:
+		return reflect.Struct
+	}
+	return reflect.Invalid
+}
+
+func (s typesShape) NumField() int {
+	return s.t.Underlying().(*types.Struct).NumFields()
+}
+
+func (s typesShape) Field(i int) (string, shape) {
+	f := s.t.Underlying().(*types.Struct).Field(i)
+	return f.Name(), typesShape{f.Type()}
+}
+
+func (s typesShape) Elem() shape {
+	switch u := s.t.Underlying().(type) {
+	case *types.Array:
+		return typesShape{u.Elem()}
+	case *types.Slice:
+		return typesShape{u.Elem()}
+	case *types.Pointer:
+		return typesShape{u.Elem()}
+	}
+	return nil
+}
+
+func (s typesShape) Len() int {
+	return int(s.t.Underlying().(*types.Array).Len())
+}
+
+func (s typesShape) Comparable() bool { return types.Comparable(s.t) }
+func (s typesShape) probe() []string  { return nil }
+
+// basicKinds maps the type checker's basic kinds onto reflect's.
+var basicKinds = map[types.BasicKind]reflect.Kind{
+	types.Bool:          reflect.Bool,
+	types.Int:           reflect.Int,
+	types.Int8:          reflect.Int8,
+	types.Int16:         reflect.Int16,
+	types.Int32:         reflect.Int32,
+	types.Int64:         reflect.Int64,
+	types.Uint:          reflect.Uint,
+	types.Uint8:         reflect.Uint8,
+	types.Uint16:        reflect.Uint16,
+	types.Uint32:        reflect.Uint32,
+	types.Uint64:        reflect.Uint64,
+	types.Uintptr:       reflect.Uintptr,
+	types.Float32:       reflect.Float32,
+	types.Float64:       reflect.Float64,
+	types.Complex64:     reflect.Complex64,
+	types.Complex128:    reflect.Complex128,
+	types.String:        reflect.String,
+	types.UnsafePointer: reflect.UnsafePointer,
+}
@@ -0,0 +1,35 @@
+package zerovalue
+
+import (
+	"go/types"
+	"testing"
+)
+
+func TestLoadTypesFromAnotherModule(t *testing.T) {
+	// The data structures guide is its own module and imports its own
+	// objgraph package, which this module can't see.
+	found, err := LoadTypes("../../datastructures")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"Person", "Address"} {
+		typ, ok := found[name]
+		if !ok {
+			t.Errorf("LoadTypes(../../datastructures) has no %s", name)
+			continue
+		}
+		if _, ok := typ.Underlying().(*types.Struct); !ok {
+			t.Errorf("%s is a %s, want a struct", name, typ.Underlying())
+		}
+	}
+}
+
+func TestLoadTypesStdlibOnly(t *testing.T) {
+	found, err := LoadTypes("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := found["Config"]; !ok {
+		t.Error("LoadTypes(..) has no Config")
+	}
+}
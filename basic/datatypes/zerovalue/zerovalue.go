@@ -0,0 +1,226 @@
+// Package zerovalue explains the zero value of any Go type: what it looks
+// like, whether you can use it without initializing it first, and whether
+// it can be compared with ==.
+//
+// Types can come from reflection (Explore) or from the type checker
+// (ExploreTypes), so the same report works for values in a running program
+// and for types loaded from source code.
+package zerovalue
+
+import (
+	"fmt"
+	"go/token"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// Usability says how far you can get with a zero value before it bites.
+type Usability int
+
+const (
+	// Usable zero values work like any other value (0, "", false, structs of those).
+	Usable Usability = iota
+	// Partial zero values support some operations but not all
+	// (a nil map can be read but not written).
+	Partial
+	// Unusable zero values panic or block on their main operation
+	// (dereferencing a nil pointer, calling a nil func).
+	Unusable
+)
+
+// String returns a short label for the usability.
+func (u Usability) String() string {
+	switch u {
+	case Usable:
+		return "usable"
+	case Partial:
+		return "partly usable"
+	case Unusable:
+		return "not usable"
+	}
+	return fmt.Sprintf("Usability(%d)", int(u))
+}
+
+// Report describes the zero value of one type. Struct and array types,
+// which hold their elements inline, have a child Report for each field or
+// for the element type.
+type Report struct {
+	Name       string // field name, or "" for the root
+	Type       string
+	Kind       reflect.Kind
+	Zero       string    // the zero value written as Go source
+	Usability  Usability // how usable the zero value is
+	Notes      []string  // what works and what doesn't
+	Comparable string    // "yes", "no", or "yes, but ..." when == can still panic
+	Children   []Report
+}
+
+// shape is the little bit of type information the rules need. It is
+// implemented for reflect.Type and go/types.Type.
+type shape interface {
+	String() string
+	Kind() reflect.Kind
+	NumField() int
+	Field(i int) (name string, s shape)
+	Elem() shape
+	Len() int
+	Comparable() bool
+	// probe performs the risky operations on a real zero value and
+	// reports what happened. Shapes without a runtime value return nil.
+	probe() []string
+}
+
+// explore applies the zero-value rules to s, recursing into the
+// inline parts of structs and arrays.
+func explore(name string, s shape) Report {
+	r := Report{Name: name, Type: s.String(), Kind: s.Kind(), Comparable: comparability(s)}
+
+	switch s.Kind() {
+	case reflect.Bool:
+		r.Zero = "false"
+	case reflect.String:
+		r.Zero = `""`
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		r.Zero = "0"
+	case reflect.Float32, reflect.Float64:
+		r.Zero = "0.0"
+	case reflect.Complex64, reflect.Complex128:
+		r.Zero = "(0+0i)"
+
+	case reflect.Slice:
+		r.Zero = "nil"
+		r.Usability = Partial
+		r.Notes = []string{"len, cap, range and append work", "indexing s[0] panics (index out of range)"}
+	case reflect.Map:
+		r.Zero = "nil"
+		r.Usability = Partial
+		r.Notes = []string{"reads, len, range and delete work", "writing m[k] = v panics"}
+	case reflect.Chan:
+		r.Zero = "nil"
+		r.Usability = Unusable
+		r.Notes = []string{"send and receive block forever", "close panics"}
+	case reflect.Func:
+		r.Zero = "nil"
+		r.Usability = Unusable
+		r.Notes = []string{"calling it panics", "only comparison with nil is allowed"}
+	case reflect.Pointer, reflect.UnsafePointer:
+		r.Zero = "nil"
+		r.Usability = Unusable
+		r.Notes = []string{"dereferencing panics", "methods with pointer receivers still run, with a nil receiver"}
+	case reflect.Interface:
+		r.Zero = "nil"
+		r.Usability = Unusable
+		r.Notes = []string{"calling a method panics", "type switches take the nil/default case"}
+
+	case reflect.Array:
+		elem := explore("[i]", s.Elem())
+		r.Children = []Report{elem}
+		r.Usability = elem.Usability
+		r.Zero = fmt.Sprintf("%s{} (%d × %s)", s.String(), s.Len(), elem.Zero)
+		if s.Len() == 0 {
+			r.Usability = Usable
+		}
+	case reflect.Struct:
+		var parts, needInit []string
+		for i := 0; i < s.NumField(); i++ {
+			fieldName, fieldShape := s.Field(i)
+			child := explore(fieldName, fieldShape)
+			r.Children = append(r.Children, child)
+			parts = append(parts, fieldName+": "+child.Zero)
+			// Unexported fields are the defining package's business: types
+			// like time.Time and sync.Mutex are built so nil insides are fine.
+			if child.Usability != Usable && token.IsExported(fieldName) {
+				r.Usability = Partial
+				needInit = append(needInit, fieldName)
+			}
+		}
+		r.Zero = "{" + strings.Join(parts, ", ") + "}"
+		if len(parts) == 0 {
+			r.Zero = "{}"
+		}
+		if len(needInit) > 0 {
+			r.Notes = []string{fmt.Sprintf("%d of %d fields need initializing first: %s", len(needInit), len(parts), strings.Join(needInit, ", "))}
+		}
+	default:
+		r.Zero = "?"
+	}
+
+	// What really happened beats what the rules predict.
+	if seen := s.probe(); len(seen) > 0 {
+		r.Notes = seen
+	}
+	return r
+}
+
+// comparability reports whether == works, noting the cases that compile
+// but can panic at run time because an interface holds an uncomparable value.
+func comparability(s shape) string {
+	if !s.Comparable() {
+		return "no"
+	}
+	if containsInterface(s) {
+		return "yes, but == panics if an interface holds a slice, map or func"
+	}
+	return "yes"
+}
+
+func containsInterface(s shape) bool {
+	switch s.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Array:
+		return containsInterface(s.Elem())
+	case reflect.Struct:
+		for i := 0; i < s.NumField(); i++ {
+			if _, f := s.Field(i); containsInterface(f) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Print writes r as a tree with the guide's ANSI colors.
+func Print(w io.Writer, r Report) {
+	printNode(w, r, "", true, true)
+}
+
+func printNode(w io.Writer, r Report, prefix string, last, root bool) {
+	connector, childPrefix := "├── ", prefix+"│   "
+	if last {
+		connector, childPrefix = "└── ", prefix+"    "
+	}
+	if root {
+		connector, childPrefix = "", ""
+	}
+
+	color := "\033[32m"
+	switch r.Usability {
+	case Partial:
+		color = "\033[33m"
+	case Unusable:
+		color = "\033[31m"
+	}
+	label := r.Type
+	if r.Name != "" {
+		label = r.Name + " " + r.Type
+	}
+	fmt.Fprintf(w, "%s%s\033[1m%s\033[0m = %s\n", prefix, connector, label, r.Zero)
+
+	// Detail lines sit under the label, keeping the tree's vertical line
+	// going when children follow.
+	detail := childPrefix + "  "
+	if len(r.Children) > 0 {
+		detail = childPrefix + "│ "
+	}
+	fmt.Fprintf(w, "%s\033[90mkind:\033[0m %s  \033[90musable:\033[0m %s%s\033[0m  \033[90mcomparable:\033[0m %s\n", detail, r.Kind, color, r.Usability, r.Comparable)
+	for _, note := range r.Notes {
+		fmt.Fprintf(w, "%s\033[90m• %s\033[0m\n", detail, note)
+	}
+
+	for i, child := range r.Children {
+		printNode(w, child, childPrefix, i == len(r.Children)-1, false)
+	}
+}
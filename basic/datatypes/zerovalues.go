@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"time"
+
+	"golang/datatypes/zerovalue"
+)
+
+// Config mixes fields whose zero values behave very differently. A
+// zero Config is valid Go, but only some of it is safe to use.
+type Config struct {
+	Name     string
+	Retries  int
+	Timeout  time.Duration
+	Tags     []string
+	Limits   map[string]int
+	Done     chan struct{}
+	OnError  func(error)
+	Parent   *Config
+	Metadata any
+	Window   [2]float64
+}
+
+// zeroValueExplorerSection extends the zero value table to composite types,
+// using the zerovalue package to decide what each zero value can do. Nil
+// slices, maps, channels and funcs are exercised for real with reflect.
+func zeroValueExplorerSection() {
+	// --- 6.1 Zero Values of Everything Else ---
+	// Every type has a zero value, but "nil" means different things:
+	// a nil slice is ready to use, a nil map is read-only, a nil chan blocks forever.
+	reports := []zerovalue.Report{
+		zerovalue.Of[[]int](),
+		zerovalue.Of[map[string]int](),
+		zerovalue.Of[chan int](),
+		zerovalue.Of[func()](),
+		zerovalue.Of[*int](),
+		zerovalue.Of[error](),
+		zerovalue.Of[any](),
+		zerovalue.Of[[3]bool](),
+		zerovalue.Of[time.Time](),
+		zerovalue.Of[struct{}](),
+	}
+
+	fmt.Printf("\n\033[1;33m▶ ZERO VALUES OF COMPOSITE TYPES (is nil safe?)\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-15s | %-12s | %-15s | %-10s\n", "Type", "Zero Value", "Usable As-Is", "Comparable")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	for _, r := range reports {
+		zero := r.Zero
+		if len(zero) > 12 {
+			zero = zero[:9] + "..."
+		}
+		comparable := r.Comparable
+		if len(comparable) > 3 {
+			comparable = "yes*"
+		}
+		fmt.Printf("%-15s | %-12s | %-15s | %-10s\n", r.Type, zero, r.Usability, comparable)
+	}
+	fmt.Println("* compiles, but == panics at run time if the interface holds a slice, map or func.")
+
+	fmt.Printf("\n%s\n", "What actually happens (tried with reflect, panics recovered):")
+	for _, r := range reports[:4] {
+		fmt.Printf("  \033[1m%s\033[0m\n", r.Type)
+		for _, note := range r.Notes {
+			fmt.Printf("    %s\n", note)
+		}
+	}
+
+	fmt.Printf("\nA struct's zero value is the zero value of every field, all the way down (%s):\n", reflect.TypeFor[Config]())
+	zerovalue.Print(os.Stdout, zerovalue.Of[Config]())
+	fmt.Println("Explore your own types: go run ./zeroexplore -dir ../datastructures Person Address")
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Sentinel errors: package-level values callers compare against. The
+// convention is an Err prefix and a lower-case message without punctuation.
+var (
+	ErrNotFound   = errors.New("not found")
+	ErrPermission = errors.New("permission denied")
+)
+
+// users is the tiny data store the lookups below run against.
+var users = map[string]string{"ada": "admin", "linus": "user", "grace": "admin"}
+
+// lookup returns a sentinel error directly, so == still works on it.
+func lookup(name string) (string, error) {
+	role, ok := users[name]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return role, nil
+}
+
+// errorRow prints a call and what came back in the annotated table style.
+func errorRow(call string, value any, err error) {
+	if err != nil {
+		fmt.Printf("  %-36s → %-8v \033[31merr: %v\033[0m\n", call, value, err)
+		return
+	}
+	fmt.Printf("  %-36s → %-8v \033[32merr: nil\033[0m\n", call, value)
+}
+
+func main() {
+	// -- 1. Errors Are Values --
+	// error is an interface with one method: Error() string. nil means success.
+	fmt.Println("\n\033[1;36m=== 1. ERRORS ARE VALUES ===\033[0m")
+	err := errors.New("something went wrong")
+	fmt.Printf("errors.New(...): %v | type %T | err.Error() = %q\n", err, err, err.Error())
+	fmt.Println("errors.New(\"x\") == errors.New(\"x\"):", errors.New("x") == errors.New("x"), "(each call makes a new pointer; same text isn't the same error)")
+	fmt.Println("fmt.Errorf builds the message like Sprintf:", fmt.Errorf("user %q: %d attempts left", "ada", 2))
+	fmt.Println("The idiom: check right after the call, handle or return, keep the happy path unindented.")
+	fmt.Println("  if err != nil { return err }  ← you'll write this a lot, and that's the point: failure is visible")
+
+	// -- 2. (value, error) APIs --
+	// Functions that can fail return the error last; the value is only meaningful when err == nil
+	fmt.Println("\n\033[1;36m=== 2. (VALUE, ERROR) APIS ===\033[0m")
+	for _, in := range []string{"42", "4x2", "", "99999999999999999999"} {
+		n, err := strconv.Atoi(in)
+		errorRow(fmt.Sprintf("strconv.Atoi(%q)", in), n, err)
+	}
+	_, err = strconv.Atoi("4x2")
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		fmt.Printf("The error is a %T: Func=%s Num=%q Err=%v\n", numErr, numErr.Func, numErr.Num, numErr.Err)
+	}
+	fmt.Println("errors.Is(err, strconv.ErrSyntax):", errors.Is(err, strconv.ErrSyntax), "| errors.Is(err, strconv.ErrRange):", errors.Is(err, strconv.ErrRange))
+
+	big, _ := strconv.ParseInt("99999999999999999999", 10, 64)
+	fmt.Printf("\033[33mGotcha:\033[0m on overflow Atoi and ParseInt return %d, not 0, along with ErrRange.\n", big)
+	fmt.Println("  Don't use the value when err != nil, unless the docs promise something about it.")
+
+	_, err = os.Open("/definitely/not/here.txt")
+	var pathErr *fs.PathError
+	errors.As(err, &pathErr)
+	fmt.Printf("os.Open(missing): %v\n  → %T{Op: %q, Path: %q} | errors.Is(err, fs.ErrNotExist): %v\n",
+		err, pathErr, pathErr.Op, pathErr.Path, errors.Is(err, fs.ErrNotExist))
+
+	role, ok := users["ada"]
+	fmt.Println(`Not everything needs an error: users["ada"] →`, role, ok, "(comma-ok when there's only one way to fail)")
+
+	// -- 3. Sentinel Errors --
+	// Exported values like io.EOF and ErrNotFound that callers can check for
+	fmt.Println("\n\033[1;36m=== 3. SENTINEL ERRORS ===\033[0m")
+	for _, name := range []string{"ada", "bob"} {
+		role, err := lookup(name)
+		errorRow(fmt.Sprintf("lookup(%q)", name), role, err)
+		if err == ErrNotFound {
+			fmt.Println("    err == ErrNotFound: the caller can react, e.g. offer to create the user")
+		}
+	}
+
+	r := strings.NewReader("ab")
+	buf := make([]byte, 1)
+	for {
+		n, err := r.Read(buf)
+		if err == io.EOF {
+			fmt.Println("Reader: io.EOF after reading everything. Not a failure, just \"done\": the most famous sentinel.")
+			break
+		}
+		fmt.Printf("Reader: read %d byte %q\n", n, buf[:n])
+	}
+	fmt.Println("\033[33mGotcha:\033[0m == stops working the moment someone wraps the error. Use errors.Is (next section).")
+
+	// -- 4. Wrapping with %w --
+	// Add context on the way up without losing the original (see wrapping.go)
+	fmt.Println("\n\033[1;36m=== 4. WRAPPING WITH %w ===\033[0m")
+	wrappingSection()
+
+	// -- 5. Custom Error Types and errors.As --
+	// Structs that carry details, and how to get them back out (see wrapping.go)
+	fmt.Println("\n\033[1;36m=== 5. CUSTOM ERROR TYPES ===\033[0m")
+	customTypesSection()
+
+	// -- 6. errors.Join --
+	// One error value that holds several (see wrapping.go)
+	fmt.Println("\n\033[1;36m=== 6. JOINING ERRORS ===\033[0m")
+	joinSection()
+
+	// -- 7. Panics vs Errors --
+	// Errors are for expected failures; panics are for bugs (see panics.go)
+	fmt.Println("\n\033[1;36m=== 7. PANICS VS ERRORS ===\033[0m")
+	panicsSection()
+
+	// -- 8. Errors in Goroutines --
+	// A goroutine can't return an error, so it has to send or store it (see panics.go)
+	fmt.Println("\n\033[1;36m=== 8. ERRORS IN GOROUTINES ===\033[0m")
+	goroutinesSection()
+
+	// -- 9. Worked Example: Validating a Person --
+	// Report every bad field at once instead of one per attempt (see validate.go)
+	fmt.Println("\n\033[1;36m=== 9. VALIDATING A PERSON ===\033[0m")
+	validateSection()
+}
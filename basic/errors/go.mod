@@ -0,0 +1,3 @@
+module golang/errors
+
+go 1.25.7
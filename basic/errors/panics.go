@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// mustAtoi is the Must pattern, like regexp.MustCompile: for input that
+// comes from the programmer, where a failure is a bug, not a condition.
+func mustAtoi(s string) int {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		panic(fmt.Sprintf("mustAtoi(%q): %v", s, err))
+	}
+	return n
+}
+
+// safely runs f and turns a panic into an error, keeping the chain when
+// the panic value was itself an error.
+func safely(f func()) (err error) {
+	defer func() {
+		switch v := recover().(type) {
+		case nil:
+		case error:
+			err = fmt.Errorf("recovered: %w", v)
+		default:
+			err = fmt.Errorf("recovered: %v", v)
+		}
+	}()
+	f()
+	return nil
+}
+
+// panicsSection contrasts the two failure mechanisms.
+func panicsSection() {
+	fmt.Printf("  %-10s | %-38s | %s\n", "", "Error", "Panic")
+	fmt.Printf("  %-10s | %-38s | %s\n", "Means", "expected failure: bad input, no file", "a bug: nil map write, index out of range")
+	fmt.Printf("  %-10s | %-38s | %s\n", "Handled by", "the caller, right there", "usually nobody: the program crashes")
+	fmt.Printf("  %-10s | %-38s | %s\n", "Visible in", "the signature: (T, error)", "nowhere until it happens")
+
+	const retries = "3" // imagine a config default compiled into the program
+	fmt.Println("mustAtoi(retries):", mustAtoi(retries), "(Must helpers panic, so only use them on input you wrote yourself)")
+
+	err := safely(func() { mustAtoi("three") })
+	fmt.Println("safely(mustAtoi(\"three\")):", err)
+
+	err = safely(func() {
+		nums := []int{1, 2, 3}
+		i := 5
+		_ = nums[i]
+	})
+	var rtErr runtime.Error
+	fmt.Println("safely(nums[5]):", err)
+	fmt.Println("  errors.As(err, &runtime.Error):", errors.As(err, &rtErr), "(the runtime panics with an error value, so the chain survives)")
+
+	err = safely(func() { panic(ErrPermission) })
+	fmt.Println("safely(panic(ErrPermission)): errors.Is(err, ErrPermission):", errors.Is(err, ErrPermission))
+
+	fmt.Println("Recover at a boundary (an HTTP handler, a worker loop), log it, carry on. Don't use panic as exceptions.")
+	fmt.Println("\033[33mGotcha:\033[0m recover only catches panics in its own goroutine. A panic in any other goroutine")
+	fmt.Println("  still takes down the whole program, so goroutines that might panic need their own safely (next section).")
+}
+
+// fetchResult pairs a value with its error so both can travel on one channel.
+type fetchResult struct {
+	id    int
+	value string
+	err   error
+}
+
+// fetchRecord pretends to load a record; odd ids over 2 fail.
+func fetchRecord(id int) (string, error) {
+	if id > 2 && id%2 == 1 {
+		return "", &NotFoundError{Kind: "record", Key: strconv.Itoa(id)}
+	}
+	return fmt.Sprintf("record-%d", id), nil
+}
+
+// processRecord is fetchRecord with a bug for id 4: a write to a nil map.
+func processRecord(id int) (string, error) {
+	if id == 4 {
+		var cache map[int]string
+		cache[id] = "processed"
+	}
+	return fetchRecord(id)
+}
+
+// goroutinesSection shows three ways to get errors out of goroutines.
+func goroutinesSection() {
+	fmt.Println("`go f()` throws away f's return values, error included. The error has to be sent or stored.")
+
+	fmt.Println("Pattern 1: send a result struct that carries its own error")
+	results := make(chan fetchResult)
+	for id := 1; id <= 4; id++ {
+		go func() {
+			v, err := fetchRecord(id)
+			results <- fetchResult{id, v, err}
+		}()
+	}
+	collected := make([]fetchResult, 5)
+	for range 4 {
+		r := <-results
+		collected[r.id] = r
+	}
+	for _, r := range collected[1:] {
+		errorRow(fmt.Sprintf("fetchRecord(%d)", r.id), r.value, r.err)
+	}
+
+	fmt.Println("Pattern 2: each goroutine stores into its own slot, then errors.Join them all")
+	ids := []int{1, 3, 4, 5}
+	errs := make([]error, len(ids)) // one slot per goroutine: no mutex needed
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Go(func() {
+			var err error
+			if p := safely(func() { _, err = processRecord(id) }); p != nil {
+				err = p // the bug becomes one more error instead of crashing the program
+			}
+			errs[i] = err
+		})
+	}
+	wg.Wait()
+	err := errors.Join(errs...)
+	fmt.Printf("  errors.Join of %d results:\n", len(ids))
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		fmt.Println("   -", e)
+	}
+
+	fmt.Println("Pattern 3: the first error cancels the rest (what errgroup.WithContext does)")
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	outcome := make([]string, 5)
+	for id := 1; id <= 4; id++ {
+		wg.Go(func() {
+			select {
+			case <-time.After(time.Duration(id) * 20 * time.Millisecond): // record id takes id×20ms
+			case <-ctx.Done():
+				outcome[id] = "cancelled"
+				return
+			}
+			if _, err := fetchRecord(id); err != nil {
+				cancel(err) // only the first cause is kept
+				outcome[id] = "failed"
+				return
+			}
+			outcome[id] = "done"
+		})
+	}
+	wg.Wait()
+	fmt.Println("  outcomes by id:", outcome[1:])
+	fmt.Println("  context.Cause(ctx):", context.Cause(ctx), "| ctx.Err():", ctx.Err())
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Validation reasons. They're sentinels so callers can ask "was anything
+// missing?" with errors.Is, whatever field it was.
+var (
+	ErrRequired     = errors.New("is required")
+	ErrFormat       = errors.New("has the wrong format")
+	ErrOutOfRange   = errors.New("is out of range")
+	ErrUnknownField = errors.New("is not a known field")
+	ErrDuplicate    = errors.New("is given more than once")
+)
+
+// FieldError says which field failed, with what value, and why.
+type FieldError struct {
+	Field string
+	Value string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	if e.Value == "" {
+		return e.Field + ": " + e.Err.Error()
+	}
+	return fmt.Sprintf("%s %q: %v", e.Field, e.Value, e.Err)
+}
+
+func (e *FieldError) Unwrap() error { return e.Err }
+
+// Person is the datastructures guide's Person with an email to validate.
+type Person struct {
+	Name  string
+	Age   int
+	Email string
+}
+
+// ParsePerson reads "name=..., age=..., email=..." and checks every field,
+// collecting all problems instead of stopping at the first. A field given
+// twice is a problem too, rather than letting the last one quietly win.
+// The error is nil, or an errors.Join of *FieldError values.
+func ParsePerson(input string) (Person, error) {
+	var errs []error
+	fields := map[string]string{}
+	for part := range strings.SplitSeq(input, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		switch {
+		case !ok:
+			errs = append(errs, &FieldError{Field: "input", Value: part, Err: ErrFormat})
+		case key != "name" && key != "age" && key != "email":
+			errs = append(errs, &FieldError{Field: key, Value: value, Err: ErrUnknownField})
+		default:
+			if _, dup := fields[key]; dup {
+				errs = append(errs, &FieldError{Field: key, Value: value, Err: ErrDuplicate})
+				continue
+			}
+			fields[key] = value
+		}
+	}
+
+	p := Person{Name: fields["name"], Email: fields["email"]}
+	if p.Name == "" {
+		errs = append(errs, &FieldError{Field: "name", Err: ErrRequired})
+	}
+
+	switch raw := fields["age"]; {
+	case raw == "":
+		errs = append(errs, &FieldError{Field: "age", Err: ErrRequired})
+	default:
+		age, err := strconv.Atoi(raw)
+		var numErr *strconv.NumError
+		switch {
+		case errors.Is(err, strconv.ErrRange), err == nil && (age < 0 || age > 150):
+			// Too many digits for an int is still a number, just out of range.
+			errs = append(errs, &FieldError{Field: "age", Value: raw, Err: fmt.Errorf("%w: want 0 to 150", ErrOutOfRange)})
+		case errors.As(err, &numErr):
+			errs = append(errs, &FieldError{Field: "age", Value: raw, Err: fmt.Errorf("%w (%w)", ErrFormat, numErr.Err)})
+		case err == nil:
+			p.Age = age
+		}
+	}
+
+	if p.Email == "" {
+		errs = append(errs, &FieldError{Field: "email", Err: ErrRequired})
+	} else if user, domain, ok := strings.Cut(p.Email, "@"); !ok || user == "" || !strings.Contains(domain, ".") {
+		errs = append(errs, &FieldError{Field: "email", Value: p.Email, Err: ErrFormat})
+	}
+
+	if len(errs) > 0 {
+		return Person{}, errors.Join(errs...)
+	}
+	return p, nil
+}
+
+// validateSection runs ParsePerson over good and bad input and shows how a
+// caller takes the joined error apart.
+func validateSection() {
+	inputs := []string{
+		"name=Ada Lovelace, age=36, email=ada@example.com",
+		"name=Linus, age=abc, email=linus@",
+		"age=-3, email=nobody",
+		"name=Grace, age=45, email=grace@navy.mil, rank=admiral",
+		"name=Ada, age=36, name=Grace, email=ada@example.com",
+		"",
+	}
+	for _, in := range inputs {
+		fmt.Printf("\033[1;33m▶ ParsePerson(%q)\033[0m\n", in)
+		p, err := ParsePerson(in)
+		if err == nil {
+			fmt.Printf("  \033[32m✓ %+v\033[0m\n", p)
+			continue
+		}
+
+		problems := err.(interface{ Unwrap() []error }).Unwrap()
+		fmt.Printf("  \033[31m✗ %d problem(s):\033[0m\n", len(problems))
+		for _, e := range problems {
+			var fe *FieldError
+			if errors.As(e, &fe) {
+				fmt.Printf("    %-8s | %v\n", fe.Field, e)
+			}
+		}
+		if errors.Is(err, ErrRequired) {
+			fmt.Println("    errors.Is(err, ErrRequired): true → highlight the empty fields")
+		}
+	}
+
+	fmt.Println("One round trip shows the user everything to fix. Stopping at the first error would take")
+	fmt.Println("three attempts to get through the empty form above.")
+	fmt.Println("err.Error() on the joined error puts one problem per line, fine for logs as is.")
+}
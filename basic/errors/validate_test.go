@@ -0,0 +1,41 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParsePerson(t *testing.T) {
+	p, err := ParsePerson("name=Ada Lovelace, age=36, email=ada@example.com")
+	if err != nil || p != (Person{Name: "Ada Lovelace", Age: 36, Email: "ada@example.com"}) {
+		t.Errorf("ParsePerson(valid) = %+v, %v", p, err)
+	}
+
+	tests := []struct {
+		in    string
+		field string
+		want  error
+	}{
+		{"age=36, email=ada@example.com", "name", ErrRequired},
+		{"name=Linus, age=abc, email=linus@example.com", "age", ErrFormat},
+		{"name=Linus, age=200, email=linus@example.com", "age", ErrOutOfRange},
+		{"name=Linus, age=99999999999999999999, email=linus@example.com", "age", ErrOutOfRange},
+		{"name=Linus, age=30, email=linus@", "email", ErrFormat},
+		{"name=Grace, age=45, email=grace@navy.mil, rank=admiral", "rank", ErrUnknownField},
+		{"name=Ada, age=36, name=Grace, email=ada@example.com", "name", ErrDuplicate},
+		{"name=Ada, age=36, email=ada@example.com, age=36", "age", ErrDuplicate},
+	}
+	for _, tt := range tests {
+		p, err := ParsePerson(tt.in)
+		var fe *FieldError
+		if !errors.Is(err, tt.want) || !errors.As(err, &fe) || fe.Field != tt.field {
+			t.Errorf("ParsePerson(%q) error = %v, want %s: %v", tt.in, err, tt.field, tt.want)
+		}
+		if joined, ok := err.(interface{ Unwrap() []error }); ok && len(joined.Unwrap()) != 1 {
+			t.Errorf("ParsePerson(%q) gave %d problems, want 1: %v", tt.in, len(joined.Unwrap()), err)
+		}
+		if p != (Person{}) {
+			t.Errorf("ParsePerson(%q) = %+v with an error, want the zero Person", tt.in, p)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// fetchUser and loadProfile each add a layer of context with %w, the way
+// errors travel up a real call stack.
+func fetchUser(name string) (string, error) {
+	role, err := lookup(name)
+	if err != nil {
+		return "", fmt.Errorf("fetch user %q: %w", name, err)
+	}
+	return role, nil
+}
+
+func loadProfile(name string) error {
+	if _, err := fetchUser(name); err != nil {
+		return fmt.Errorf("load profile: %w", err)
+	}
+	return nil
+}
+
+// wrappingSection walks a wrapped chain and compares %w with %v.
+func wrappingSection() {
+	err := loadProfile("bob")
+	fmt.Println("loadProfile(\"bob\"):", err)
+	fmt.Println("Unwrapping it one layer at a time:")
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		fmt.Printf("  %-20T %v\n", e, e)
+	}
+	fmt.Println("err == ErrNotFound:", err == ErrNotFound, "| errors.Is(err, ErrNotFound):", errors.Is(err, ErrNotFound), "(Is walks the whole chain)")
+
+	flattened := fmt.Errorf("load profile: %v", errors.Unwrap(err))
+	fmt.Printf("Same message with %%v instead of %%w: %v\n", flattened)
+	fmt.Printf("  errors.Is(flattened, ErrNotFound): %v ← %%v keeps the text, throws away the chain\n", errors.Is(flattened, ErrNotFound))
+	fmt.Printf("Wrap with %%w when callers may need the cause; format with %%v to hide it on purpose,\n")
+	fmt.Println("e.g. so an internal driver error doesn't become part of your package's API.")
+	fmt.Println("\033[33mGotcha:\033[0m say what you were doing, not that it failed: \"load profile: fetch user\", not \"failed to load profile: error fetching\".")
+}
+
+// NotFoundError carries details a sentinel can't. Pointer receivers are
+// the norm, so errors.As looks for a *NotFoundError.
+type NotFoundError struct {
+	Kind string
+	Key  string
+}
+
+func (e *NotFoundError) Error() string { return fmt.Sprintf("%s %q not found", e.Kind, e.Key) }
+
+// Is makes errors.Is(err, ErrNotFound) true for every *NotFoundError, so
+// callers checking the sentinel keep working.
+func (e *NotFoundError) Is(target error) bool { return target == ErrNotFound }
+
+// QueryError wraps a cause and exposes it with Unwrap.
+type QueryError struct {
+	Query string
+	Err   error
+}
+
+func (e *QueryError) Error() string { return "query " + e.Query + ": " + e.Err.Error() }
+func (e *QueryError) Unwrap() error { return e.Err }
+
+func findUser(name string) (string, error) {
+	role, ok := users[name]
+	if !ok {
+		return "", &QueryError{Query: "users/" + name, Err: &NotFoundError{Kind: "user", Key: name}}
+	}
+	return role, nil
+}
+
+// findUserBroken returns a typed nil: the *NotFoundError is nil, the
+// error interface holding it is not.
+func findUserBroken(name string) error {
+	var nf *NotFoundError
+	if _, ok := users[name]; !ok {
+		nf = &NotFoundError{Kind: "user", Key: name}
+	}
+	return nf
+}
+
+// customTypesSection pulls details back out of a chain with errors.As.
+func customTypesSection() {
+	_, err := findUser("bob")
+	fmt.Println("findUser(\"bob\"):", err)
+
+	var nf *NotFoundError
+	if errors.As(err, &nf) {
+		fmt.Printf("errors.As(err, &nf) found a %T two layers down: Kind=%q Key=%q\n", nf, nf.Kind, nf.Key)
+	}
+	var qe *QueryError
+	if errors.As(err, &qe) {
+		fmt.Printf("errors.As(err, &qe) found the outer %T: Query=%q\n", qe, qe.Query)
+	}
+	fmt.Println("errors.Is(err, ErrNotFound):", errors.Is(err, ErrNotFound), "(via the custom Is method)")
+
+	fmt.Println("Sentinel or type?")
+	fmt.Printf("  %-15s | %s\n", "Sentinel", "the caller only needs to know WHAT happened: io.EOF, ErrNotFound")
+	fmt.Printf("  %-15s | %s\n", "Custom type", "the caller needs details: which file, which field, which query")
+
+	err = findUserBroken("ada")
+	fmt.Printf("findUserBroken(\"ada\") != nil: %v, even though it returned a nil %T\n", err != nil, err)
+	fmt.Println("\033[33mGotcha:\033[0m always declare the result as error and return a literal nil on success.")
+	fmt.Println("\033[33mGotcha:\033[0m errors.As needs a pointer to the target: errors.As(err, &nf), not errors.As(err, nf). go vet catches it.")
+}
+
+// closer is a resource whose Close can fail too.
+type closer struct {
+	name string
+	fail bool
+}
+
+func (c closer) Close() error {
+	if c.fail {
+		return fmt.Errorf("close %s: %w", c.name, ErrPermission)
+	}
+	return nil
+}
+
+// writeReport keeps both the work error and the Close error instead of
+// letting a deferred Close swallow one.
+func writeReport(res closer, work error) (err error) {
+	defer func() { err = errors.Join(err, res.Close()) }()
+	return work
+}
+
+// joinSection shows errors.Join, multiple %w verbs and the Close pattern.
+func joinSection() {
+	joined := errors.Join(ErrNotFound, ErrPermission)
+	fmt.Printf("errors.Join(ErrNotFound, ErrPermission): %q (one per line)\n", joined.Error())
+	fmt.Println("  errors.Is(joined, ErrNotFound):", errors.Is(joined, ErrNotFound), "| errors.Is(joined, ErrPermission):", errors.Is(joined, ErrPermission))
+	fmt.Println("  errors.Join(nil, nil) == nil:", errors.Join(nil, nil) == nil, "(nils are dropped, so you can join unconditionally)")
+
+	both := fmt.Errorf("sync %q: %w, then %w", "ada", ErrNotFound, ErrPermission)
+	fmt.Println("fmt.Errorf with two %w (Go 1.20):", both, "| Is both:", errors.Is(both, ErrNotFound) && errors.Is(both, ErrPermission))
+
+	if multi, ok := joined.(interface{ Unwrap() []error }); ok {
+		fmt.Println("Unwrap() []error gives the parts back:", multi.Unwrap())
+	}
+	fmt.Println("\033[33mGotcha:\033[0m errors.Unwrap(joined) is", errors.Unwrap(joined), "- it only follows Unwrap() error, not []error.")
+
+	fmt.Println("Keeping a deferred Close error:")
+	fmt.Println("  writeReport(ok, nil):           ", writeReport(closer{"report.txt", false}, nil))
+	fmt.Println("  writeReport(failing, nil):      ", writeReport(closer{"report.txt", true}, nil))
+	fmt.Printf("  writeReport(failing, disk full): %q\n", writeReport(closer{"report.txt", true}, errors.New("disk full")))
+}
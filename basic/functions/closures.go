@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+// counter returns a closure over count: every call to the returned
+// function sees and updates the same variable, which outlives counter.
+func counter() func() int {
+	count := 0
+	return func() int {
+		count++
+		return count
+	}
+}
+
+// memoize wraps f with a cache that only the returned function can reach.
+func memoize(f func(int) int) (cached func(int) int, calls *int) {
+	cache := map[int]int{}
+	calls = new(int)
+	cached = func(n int) int {
+		if v, ok := cache[n]; ok {
+			return v
+		}
+		*calls++
+		cache[n] = f(n)
+		return cache[n]
+	}
+	return cached, calls
+}
+
+// closuresSection shows that closures capture variables, not values.
+func closuresSection() {
+	next := counter()
+	fmt.Println("next := counter(); next(), next(), next():", next(), next(), next())
+	other := counter()
+	fmt.Println("other := counter(); other():", other(), "(a new call, a new count)")
+
+	x := 10
+	show := func() int { return x } // captures the variable x itself
+	x = 20
+	fmt.Println("Captured x after x = 20:", show(), "(closures see later changes: they capture by reference)")
+
+	square, calls := memoize(func(n int) int { return n * n })
+	for _, n := range []int{4, 4, 5, 4, 5} {
+		square(n)
+	}
+	fmt.Println("memoize(square) called with 4, 4, 5, 4, 5: computed", *calls, "times")
+
+	fmt.Println("Private state without a struct: nothing outside counter() can touch count.")
+}
+
+// loopVariablesSection runs the classic closure-in-a-loop bug under both
+// loop semantics: this file's (Go 1.22+, one variable per iteration) and
+// loopvar_go121.go's, which is compiled as Go 1.21 (one shared variable).
+func loopVariablesSection() {
+	var funcs []func() int
+	for i := 0; i < 3; i++ {
+		funcs = append(funcs, func() int { return i })
+	}
+	fmt.Print("Go 1.22+ for i := 0; i < 3; i++ closures return: ")
+	for _, f := range funcs {
+		fmt.Print(f(), " ")
+	}
+	fmt.Println("\033[32m(each iteration has its own i)\033[0m")
+
+	fmt.Print("Go 1.21   the same loop, file compiled as go1.21:  ")
+	for _, f := range oldLoopClosures() {
+		fmt.Print(f(), " ")
+	}
+	fmt.Println("\033[31m(one i shared by all, read after the loop ended)\033[0m")
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var seen []string
+	for _, name := range []string{"ann", "bob", "cy"} {
+		wg.Go(func() {
+			mu.Lock()
+			seen = append(seen, name)
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+	fmt.Printf("Goroutines started in a range loop over 3 names saw %d different ones \033[32m(each got its own name)\033[0m\n", distinct(seen))
+	fmt.Print("Under Go 1.21, closures made in that range loop all return: ")
+	fmt.Println(oldRangeCapture([]string{"ann", "bob", "cy"}))
+
+	fmt.Println("The go line in go.mod decides which semantics a module gets; the old fix was i := i inside the loop.")
+}
+
+func distinct(s []string) int {
+	set := map[string]bool{}
+	for _, v := range s {
+		set[v] = true
+	}
+	return len(set)
+}
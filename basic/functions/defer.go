@@ -0,0 +1,183 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// trace logs entering a function and returns the func that logs leaving
+// it, so `defer trace("name")()` brackets the whole body.
+func trace(name string, log *[]string) func() {
+	*log = append(*log, "enter "+name)
+	return func() { *log = append(*log, "leave "+name) }
+}
+
+// deferOrder defers three calls and records the order they run in.
+func deferOrder() (log []string) {
+	for i := 1; i <= 3; i++ {
+		defer func() { log = append(log, fmt.Sprint("deferred ", i)) }()
+	}
+	log = append(log, "body done")
+	return log
+}
+
+// argumentsNow shows when the arguments of a deferred call are evaluated:
+// at the defer statement, not when the call finally runs.
+func argumentsNow() (atDefer, atReturn string) {
+	x := "first"
+	var out1, out2 string
+	func() {
+		defer func(v string) { out1 = v }(x) // x evaluated right here
+		defer func() { out2 = x }()          // x read when the deferred func runs
+		x = "second"
+	}()
+	return out1, out2
+}
+
+// doubleOnReturn changes its named result after the return statement has
+// set it: deferred functions run after the result is assigned but before
+// the caller gets it.
+func doubleOnReturn() (n int) {
+	defer func() { n *= 2 }()
+	return 21
+}
+
+// closeAll is the usual cleanup shape: the error from a deferred Close is
+// kept if nothing else failed first.
+func closeAll(fail bool) (err error) {
+	r := &resource{name: "db", failClose: fail}
+	defer func() {
+		if cerr := r.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+	return nil
+}
+
+type resource struct {
+	name      string
+	failClose bool
+}
+
+func (r *resource) Close() error {
+	if r.failClose {
+		return errors.New("close " + r.name + ": connection reset")
+	}
+	return nil
+}
+
+// deferSection shows defer's ordering and evaluation rules.
+func deferSection() {
+	fmt.Println("Order (LIFO, like a stack):", strings.Join(deferOrder(), " → "))
+
+	var log []string
+	func() {
+		defer trace("work", &log)() // trace("work") runs now, its result runs at the end
+		log = append(log, "  doing work")
+	}()
+	fmt.Println("defer trace(\"work\")():", strings.Join(log, " → "))
+
+	atDefer, atReturn := argumentsNow()
+	fmt.Printf("defer f(x) saw %q (argument evaluated at the defer); defer func(){ use x } saw %q\n", atDefer, atReturn)
+
+	fmt.Println("doubleOnReturn() with `return 21` and a defer doubling the named result:", doubleOnReturn())
+	fmt.Println("closeAll(false):", closeAll(false), "| closeAll(true):", closeAll(true))
+
+	fmt.Println("Gotcha: defer in a loop runs at function end, not iteration end. 1,000 files opened")
+	fmt.Println("in a loop with defer f.Close() stay open until the function returns; wrap the body in a func.")
+}
+
+// safeDivide turns a runtime panic into an ordinary error.
+func safeDivide(a, b int) (result int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered: %v", r)
+		}
+	}()
+	return a / b, nil
+}
+
+// panicKind recovers a panic and reports what kind of value it carried.
+func panicKind(f func()) (kind string) {
+	defer func() {
+		switch r := recover().(type) {
+		case nil:
+			kind = "no panic"
+		case runtime.Error:
+			kind = "runtime.Error: " + r.Error()
+		case error:
+			kind = "error: " + r.Error()
+		default:
+			kind = fmt.Sprintf("%T: %v", r, r)
+		}
+	}()
+	f()
+	return
+}
+
+// goSafely runs f in a new goroutine that can't crash the program: a
+// panic is recovered there, in the goroutine that panicked, and
+// reported as an error on the returned channel.
+func goSafely(f func()) <-chan error {
+	errc := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				errc <- fmt.Errorf("goroutine panicked: %v", r)
+			}
+			close(errc)
+		}()
+		f()
+	}()
+	return errc
+}
+
+// panicSection shows what recover can and can't catch.
+func panicSection() {
+	fmt.Println("safeDivide(10, 2):", fmt.Sprint(safeDivide(10, 2)))
+	fmt.Println("safeDivide(1, 0): ", fmt.Sprint(safeDivide(1, 0)))
+
+	var m map[string]int
+	var p *resource
+	var arr []int
+	for _, c := range []struct {
+		name string
+		f    func()
+	}{
+		{"nil map write", func() { m["x"] = 1 }},
+		{"nil pointer field", func() { _ = p.name }},
+		{"index out of range", func() { _ = arr[3] }},
+		{"panic(errors.New)", func() { panic(errors.New("custom failure")) }},
+		{`panic("string")`, func() { panic("something bad") }},
+		{"no panic at all", func() {}},
+	} {
+		fmt.Printf("  %-20s → %s\n", c.name, panicKind(c.f))
+	}
+
+	fmt.Println("\nAcross goroutines: recover in main can't catch a panic in another goroutine.")
+	fmt.Println("An unrecovered panic in any goroutine kills the whole program, so recover inside it:")
+	var wg sync.WaitGroup
+	results := make([]error, 3)
+	for i := range 3 {
+		wg.Go(func() {
+			results[i] = <-goSafely(func() {
+				if i == 1 {
+					var boom []int
+					_ = boom[i]
+				}
+			})
+		})
+	}
+	wg.Wait()
+	for i, err := range results {
+		if err != nil {
+			fmt.Printf("  worker %d: \033[31m%v\033[0m\n", i, err)
+		} else {
+			fmt.Printf("  worker %d: \033[32mok\033[0m\n", i)
+		}
+	}
+	fmt.Println("Rule: panic for programmer bugs (impossible states), return errors for everything expected.")
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// sum is variadic: nums is a []int holding however many arguments were passed.
+func sum(nums ...int) int {
+	total := 0
+	for _, n := range nums {
+		total += n
+	}
+	return total
+}
+
+// join takes a fixed parameter first; the variadic one must come last.
+func join(sep string, parts ...string) string {
+	return strings.Join(parts, sep)
+}
+
+// divmod returns two results, the second usually being an error or a bool.
+func divmod(a, b int) (int, int) {
+	return a / b, a % b
+}
+
+// parseAge shows the (value, error) convention every Go API follows.
+func parseAge(s string) (int, error) {
+	age, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("age %q: %w", s, err)
+	}
+	if age < 0 {
+		return 0, errors.New("age can't be negative")
+	}
+	return age, nil
+}
+
+// stats has named results: they start at their zero values, document what
+// each result means, and a bare return returns their current values.
+func stats(nums []int) (min, max int, mean float64) {
+	if len(nums) == 0 {
+		return // 0, 0, 0
+	}
+	min, max = nums[0], nums[0]
+	for _, n := range nums {
+		if n < min {
+			min = n
+		}
+		if n > max {
+			max = n
+		}
+	}
+	mean = float64(sum(nums...)) / float64(len(nums))
+	return
+}
+
+// operation is a function type: any func(int, int) int can be used where
+// an operation is expected.
+type operation func(a, b int) int
+
+// apply is a higher-order function: it takes a function as an argument.
+func apply(op operation, a, b int) int {
+	return op(a, b)
+}
+
+// multiplier returns a function: a new one for each factor.
+func multiplier(factor int) func(int) int {
+	return func(n int) int { return n * factor }
+}
+
+func main() {
+	// -- 1. Functions and Variadic Parameters --
+	// func name(params) results. The last parameter may be ...T and take any number of values.
+	fmt.Println("\n\033[1;36m=== 1. VARIADIC FUNCTIONS ===\033[0m")
+	fmt.Println("sum():", sum(), "| sum(1, 2):", sum(1, 2), "| sum(1, 2, 3, 4):", sum(1, 2, 3, 4))
+	nums := []int{10, 20, 30}
+	fmt.Println("sum(nums...):", sum(nums...), "(spreads a slice into the variadic parameter)")
+	fmt.Println(`join("-", "a", "b", "c"):`, join("-", "a", "b", "c"))
+	fmt.Printf("Type of sum: %T (inside the function, nums is just a []int)\n", sum)
+
+	shared := []int{1, 2, 3}
+	zeroFirst := func(nums ...int) { nums[0] = 0 }
+	zeroFirst(shared...)
+	fmt.Println("Gotcha: f(s...) passes the slice itself, not a copy. After zeroFirst(shared...):", shared)
+
+	// -- 2. Multiple Returns and Named Results --
+	// Go functions can return several values. Errors come last, by convention.
+	fmt.Println("\n\033[1;36m=== 2. MULTIPLE RETURNS AND NAMED RESULTS ===\033[0m")
+	q, r := divmod(17, 5)
+	fmt.Println("divmod(17, 5):", q, r)
+	_, r = divmod(17, 3) // _ discards a result you don't need
+	fmt.Println("Remainder only, divmod(17, 3):", r)
+
+	for _, input := range []string{"42", "forty-two", "-1"} {
+		if age, err := parseAge(input); err != nil {
+			fmt.Printf("parseAge(%q): \033[31merror:\033[0m %v\n", input, err)
+		} else {
+			fmt.Printf("parseAge(%q): %d\n", input, age)
+		}
+	}
+
+	lo, hi, mean := stats([]int{4, 8, 15, 16, 23, 42})
+	fmt.Printf("stats(...) with named results (min, max int, mean float64): %d %d %.2f\n", lo, hi, mean)
+	lo, hi, mean = stats(nil)
+	fmt.Printf("stats(nil), bare return of untouched named results: %d %d %.2f\n", lo, hi, mean)
+	fmt.Println("Named results shine in docs and with defer (section 6). Bare returns in long functions hurt readability.")
+
+	// -- 3. First-Class Functions --
+	// Functions are values: store them in variables, maps and slices, pass and return them.
+	fmt.Println("\n\033[1;36m=== 3. FIRST-CLASS FUNCTIONS ===\033[0m")
+	add := func(a, b int) int { return a + b } // function literal
+	ops := map[string]operation{
+		"+": add,
+		"-": func(a, b int) int { return a - b },
+		"*": func(a, b int) int { return a * b },
+	}
+	keys := make([]string, 0, len(ops))
+	for k := range ops {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Printf("apply(ops[%q], 6, 3) = %d\n", k, apply(ops[k], 6, 3))
+	}
+	double, triple := multiplier(2), multiplier(3)
+	fmt.Println("multiplier(2)(21):", double(21), "| multiplier(3)(21):", triple(21))
+
+	var nothing func()
+	fmt.Println("Zero value of a func type:", nothing == nil, "(calling it panics; funcs compare only to nil)")
+
+	words := []string{"banana", "Apple", "cherry"}
+	sort.Slice(words, func(i, j int) bool { return strings.ToLower(words[i]) < strings.ToLower(words[j]) })
+	fmt.Println("sort.Slice with a less func:", words)
+
+	// -- 4. Closures --
+	// A function literal that uses variables from the enclosing scope keeps them alive (see closures.go)
+	fmt.Println("\n\033[1;36m=== 4. CLOSURES ===\033[0m")
+	closuresSection()
+
+	// -- 5. Loop Variables and Go 1.22 --
+	// Each iteration now has its own variable; before 1.22 they shared one (see closures.go)
+	fmt.Println("\n\033[1;36m=== 5. LOOP VARIABLES (GO 1.22) ===\033[0m")
+	loopVariablesSection()
+
+	// -- 6. Defer --
+	// Deferred calls run when the function returns, last in first out (see defer.go)
+	fmt.Println("\n\033[1;36m=== 6. DEFER ===\033[0m")
+	deferSection()
+
+	// -- 7. Panic and Recover --
+	// recover only works in a deferred call, and only in the goroutine that panicked (see defer.go)
+	fmt.Println("\n\033[1;36m=== 7. PANIC AND RECOVER ===\033[0m")
+	panicSection()
+
+	// -- 8. Method Values and Method Expressions --
+	// t.Method is a function bound to t; T.Method is a function taking t first (see methods.go)
+	fmt.Println("\n\033[1;36m=== 8. METHOD VALUES AND EXPRESSIONS ===\033[0m")
+	methodValuesSection()
+}
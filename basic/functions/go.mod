@@ -0,0 +1,3 @@
+module golang/functions
+
+go 1.25.7
@@ -0,0 +1,32 @@
+//go:build go1.21
+
+// The go1.21 build constraint above is always satisfied by a newer
+// toolchain, but it also makes the compiler treat this file as Go 1.21,
+// so its loops still share one variable across iterations. It exists
+// only to show the old behavior next to the new one.
+
+package main
+
+// oldLoopClosures is the same loop as in loopVariablesSection, compiled
+// with pre-1.22 semantics.
+func oldLoopClosures() []func() int {
+	var funcs []func() int
+	for i := 0; i < 3; i++ {
+		funcs = append(funcs, func() int { return i })
+	}
+	return funcs
+}
+
+// oldRangeCapture captures the range variable in closures run after the
+// loop. With one shared variable they all see the last element.
+func oldRangeCapture(names []string) []string {
+	var funcs []func() string
+	for _, name := range names {
+		funcs = append(funcs, func() string { return name })
+	}
+	var got []string
+	for _, f := range funcs {
+		got = append(got, f())
+	}
+	return got
+}
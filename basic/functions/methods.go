@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Tally counts things. Inc needs a pointer receiver to change it; Total
+// only reads it, so a value receiver will do.
+type Tally struct {
+	Label string
+	N     int
+}
+
+func (t *Tally) Inc()        { t.N++ }
+func (t Tally) Total() int   { return t.N }
+func (t Tally) Show() string { return fmt.Sprintf("%s=%d", t.Label, t.N) }
+
+// methodValuesSection contrasts method values (bound to one receiver)
+// with method expressions (receiver passed as the first argument).
+func methodValuesSection() {
+	t := Tally{Label: "clicks"}
+
+	inc := t.Inc // method value: bound to &t, a func()
+	inc()
+	inc()
+	fmt.Printf("inc := t.Inc; inc(); inc() → t.N = %d   (type %T)\n", t.N, inc)
+
+	show := t.Show // value receiver: t is copied right now
+	t.Inc()
+	fmt.Printf("show := t.Show before t.Inc() → show() = %q, t.Show() = %q\n", show(), t.Show())
+	fmt.Println("  A method value with a value receiver keeps the copy taken when it was created.")
+
+	total := Tally.Total  // method expression: func(Tally) int
+	incBy := (*Tally).Inc // pointer receiver: func(*Tally)
+	incBy(&t)
+	fmt.Printf("Tally.Total is %T → Tally.Total(t) = %d\n", total, total(t))
+	fmt.Printf("(*Tally).Inc is %T, called as (*Tally).Inc(&t)\n", incBy)
+
+	tallies := []Tally{{"a", 3}, {"b", 1}, {"c", 2}}
+	fmt.Println("Method expression as a mapper: totals =", mapTallies(tallies, Tally.Total))
+	fmt.Println("Tally.Show as a formatter:", strings.Join(mapStrings(tallies, Tally.Show), ", "))
+
+	buttons := map[string]func(){"like": t.Inc} // callbacks bound to a receiver, no closure needed
+	buttons["like"]()
+	fmt.Println("After buttons[\"like\"]() bound to t.Inc:", t.Show())
+}
+
+func mapTallies(ts []Tally, f func(Tally) int) []int {
+	out := make([]int, len(ts))
+	for i, t := range ts {
+		out[i] = f(t)
+	}
+	return out
+}
+
+func mapStrings(ts []Tally, f func(Tally) string) []string {
+	out := make([]string, len(ts))
+	for i, t := range ts {
+		out[i] = f(t)
+	}
+	return out
+}
@@ -0,0 +1,76 @@
+package main
+
+import "fmt"
+
+// SumAny is Sum written the pre-generics way: every element is boxed in
+// an interface and unpacked again with a type switch.
+func SumAny(nums []any) any {
+	if len(nums) == 0 {
+		return nil
+	}
+	switch nums[0].(type) {
+	case int:
+		total := 0
+		for _, n := range nums {
+			total += n.(int)
+		}
+		return total
+	case float64:
+		total := 0.0
+		for _, n := range nums {
+			total += n.(float64)
+		}
+		return total
+	}
+	panic(fmt.Sprintf("SumAny: unsupported element type %T", nums[0]))
+}
+
+// anyStack is Stack before generics: it holds anything, so callers must
+// assert on the way out, and non-pointer values are boxed on the way in.
+type anyStack struct {
+	items []any
+}
+
+func (s *anyStack) Push(v any) { s.items = append(s.items, v) }
+
+func (s *anyStack) Pop() (any, bool) {
+	if len(s.items) == 0 {
+		return nil, false
+	}
+	v := s.items[len(s.items)-1]
+	s.items[len(s.items)-1] = nil
+	s.items = s.items[:len(s.items)-1]
+	return v, true
+}
+
+// maxAny is Max for interface values, with one case per supported type.
+func maxAny(a, b any) any {
+	switch x := a.(type) {
+	case int:
+		if y := b.(int); y > x {
+			return y
+		}
+	case float64:
+		if y := b.(float64); y > x {
+			return y
+		}
+	case string:
+		if y := b.(string); y > x {
+			return y
+		}
+	default:
+		panic(fmt.Sprintf("maxAny: unsupported type %T", a))
+	}
+	return a
+}
+
+// benchmarkSection checks both versions agree. The timings come from the
+// benchmarks in generics_test.go.
+func benchmarkSection() {
+	fmt.Println("Sum([]int{1, 2, 3}):", Sum([]int{1, 2, 3}), "| SumAny([]any{1, 2, 3}):", SumAny([]any{1, 2, 3}))
+	fmt.Println(`  SumAny([]any{1, "2"}) compiles fine and panics at run time; Sum([]int{1, "2"}) is a compile error.`)
+	fmt.Println("  The any version also only knows int and float64: a []Celsius needs another case. Sum takes it as is.")
+	fmt.Println("\nTo time them on this machine: go test -bench . -benchmem")
+	fmt.Println("  Generic code is compiled per GC shape, so ints stay ints: no boxing, no assertions.")
+	fmt.Println("  Pointer types share one shape and go through a dictionary; they were never boxed, so they gain less.")
+}
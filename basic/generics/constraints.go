@@ -0,0 +1,113 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// Number is a union: T must be exactly one of these types...
+type Number interface {
+	int | int32 | int64 | float32 | float64
+}
+
+// Numeric is the same list with ~, which also admits every type whose
+// underlying type is in the list, like Celsius below.
+type Numeric interface {
+	~int | ~int32 | ~int64 | ~float32 | ~float64
+}
+
+// Stringish mixes a type set with a method: T must be a string-based type
+// AND have a String method. Interfaces like this only work as constraints.
+type Stringish interface {
+	~string
+	String() string
+}
+
+type Celsius float64
+type UserID int
+
+type Color string
+
+func (c Color) String() string { return "color:" + string(c) }
+
+func SumExact[T Number](nums []T) T {
+	var total T
+	for _, n := range nums {
+		total += n
+	}
+	return total
+}
+
+func Sum[T Numeric](nums []T) T {
+	var total T
+	for _, n := range nums {
+		total += n
+	}
+	return total
+}
+
+// Shout can use both string operations (from ~string) and the method.
+func Shout[T Stringish](v T) string {
+	return v.String() + " (" + fmt.Sprint(len(v)) + " bytes)"
+}
+
+// Clamp uses cmp.Ordered, the standard constraint for < <= >= >.
+func Clamp[T cmp.Ordered](v, lo, hi T) T {
+	return min(max(v, lo), hi)
+}
+
+// constraintsSection shows union constraints with and without ~, methods
+// in constraints, and the standard cmp.Ordered.
+func constraintsSection() {
+	fmt.Println("SumExact([]int{1, 2, 3}):", SumExact([]int{1, 2, 3}), "| SumExact([]float64{0.5, 0.25}):", SumExact([]float64{0.5, 0.25}))
+	temps := []Celsius{20.5, 21, 19.5}
+	fmt.Printf("Sum([]Celsius{...}): %v %T (needs ~float64: Celsius isn't float64, it's based on it)\n", Sum(temps), Sum(temps))
+	fmt.Println("  SumExact(temps) doesn't compile: Celsius does not satisfy Number (possibly missing ~ for float64 in Number)")
+	fmt.Printf("Sum([]UserID{1, 2}): %v %T\n", Sum([]UserID{1, 2}), Sum([]UserID{1, 2}))
+
+	fmt.Println(`Shout(Color("teal")):`, Shout(Color("teal")), "| a plain string has no String method, so it's rejected")
+
+	fmt.Println("Clamp(15, 0, 10):", Clamp(15, 0, 10), `| Clamp("m", "a", "k"):`, Clamp("m", "a", "k"), "| Clamp(-2.5, -1.0, 1.0):", Clamp(-2.5, -1.0, 1.0))
+	fmt.Println("cmp.Compare(2, 10):", cmp.Compare(2, 10), `| cmp.Compare("b", "a"):`, cmp.Compare("b", "a"), "| cmp.Less(1.5, 2.0):", cmp.Less(1.5, 2.0))
+
+	type Person struct {
+		Name string
+		Age  int
+	}
+	people := []Person{{"Ada", 36}, {"Linus", 21}, {"Grace", 45}, {"Alan", 36}}
+	slices.SortFunc(people, func(a, b Person) int {
+		return cmp.Or(cmp.Compare(a.Age, b.Age), cmp.Compare(a.Name, b.Name)) // age, then name
+	})
+	fmt.Println("slices.SortFunc with cmp.Or(cmp.Compare(age), cmp.Compare(name)):", people)
+
+	fmt.Println("Constraint cheat sheet:")
+	fmt.Println("  any            → every type, so only what every type supports: assign, pass, store (no ==)")
+	fmt.Println("  comparable     → == and !=")
+	fmt.Println("  cmp.Ordered    → < <= > >= (~ints, ~uints, ~floats, ~string)")
+	fmt.Println("  A | B          → exactly A or B; operators allowed if all members support them")
+	fmt.Println("  ~A             → A and every type defined as `type X A`")
+}
+
+// key is a struct whose fields are all comparable, so it is too.
+type key struct {
+	User string
+	ID   int
+}
+
+// comparableSection shows what satisfies comparable, including the Go
+// 1.20 rule that lets interface types in, with a run-time catch.
+func comparableSection() {
+	fmt.Println("Index([]key{...}, key{\"bob\", 2}):", Index([]key{{"ann", 1}, {"bob", 2}}, key{"bob", 2}), "(structs of comparable fields are comparable)")
+	fmt.Println("Index([][2]int{...}, [2]int{3, 4}):", Index([][2]int{{1, 2}, {3, 4}}, [2]int{3, 4}), "(arrays too)")
+	fmt.Println("Index([]*int{p, q}, q):", func() int { a, b := 1, 1; return Index([]*int{&a, &b}, &b) }(), "(pointers compare by address, not by what they point to)")
+	fmt.Println("  Index([][]int{...}, ...) doesn't compile: slices, maps and funcs are never comparable.")
+
+	vals := []any{1, "two", 3.0}
+	fmt.Println(`Index([]any{1, "two", 3.0}, any("two")):`, Index(vals, any("two")), "(Go 1.20+: interfaces satisfy comparable)")
+	func() {
+		defer func() { fmt.Println("Index([]any{[]int{1}}, ...): \033[31mpanic:\033[0m", recover()) }()
+		Index([]any{[]int{1}}, any([]int{1}))
+	}()
+	fmt.Println("  The catch: an interface is comparable at compile time even when the value inside isn't.")
+}
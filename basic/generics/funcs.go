@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Map applies f to every element, possibly changing the element type.
+func Map[T, U any](s []T, f func(T) U) []U {
+	out := make([]U, len(s))
+	for i, v := range s {
+		out[i] = f(v)
+	}
+	return out
+}
+
+// Filter keeps the elements for which keep returns true.
+func Filter[T any](s []T, keep func(T) bool) []T {
+	var out []T
+	for _, v := range s {
+		if keep(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Reduce folds s into one value, starting from initial.
+func Reduce[T, A any](s []T, initial A, f func(A, T) A) A {
+	acc := initial
+	for _, v := range s {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// GroupBy buckets elements by a key computed from each one.
+func GroupBy[T any, K comparable](s []T, key func(T) K) map[K][]T {
+	groups := map[K][]T{}
+	for _, v := range s {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// Person is the same shape as in the other guides.
+type Person struct {
+	Name string
+	Age  int
+	City string
+}
+
+// functionalSection chains the helpers on plain numbers and on people.
+func functionalSection() {
+	nums := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	evens := Filter(nums, func(n int) bool { return n%2 == 0 })
+	squares := Map(evens, func(n int) int { return n * n })
+	total := Reduce(squares, 0, func(acc, n int) int { return acc + n })
+	fmt.Println("nums:", nums)
+	fmt.Println("  Filter(even):", evens, "→ Map(square):", squares, "→ Reduce(sum):", total)
+
+	people := []Person{
+		{"Ada", 36, "London"}, {"Linus", 21, "Helsinki"}, {"Grace", 45, "Arlington"},
+		{"Alan", 41, "London"}, {"Ken", 30, "Arlington"},
+	}
+	names := Map(people, func(p Person) string { return p.Name })
+	over35 := Filter(people, func(p Person) bool { return p.Age > 35 })
+	ageSum := Reduce(people, 0, func(acc int, p Person) int { return acc + p.Age })
+	fmt.Println("Map(people, name):", names)
+	fmt.Println("Filter(people, age > 35):", Map(over35, func(p Person) string { return p.Name }))
+	fmt.Printf("Reduce(people, 0, +age): %d, average %.1f\n", ageSum, float64(ageSum)/float64(len(people)))
+
+	byCity := GroupBy(people, func(p Person) string { return p.City })
+	cities := make([]string, 0, len(byCity))
+	for c := range byCity {
+		cities = append(cities, c)
+	}
+	slices.Sort(cities)
+	for _, c := range cities {
+		fmt.Printf("  GroupBy(city) %-10s → %s\n", c, strings.Join(Map(byCity[c], func(p Person) string { return p.Name }), ", "))
+	}
+
+	csv := Reduce(names, "", func(acc, name string) string {
+		if acc == "" {
+			return name
+		}
+		return acc + "," + name
+	})
+	fmt.Println("Reduce can change the type too (here []string → string):", csv)
+	fmt.Println("The standard library has the common ones: slices.Index, slices.Contains, slices.SortFunc, maps.Keys...")
+}
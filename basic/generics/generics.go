@@ -0,0 +1,103 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// Max works for any ordered type: ints, floats, strings and anything
+// whose underlying type is one of them.
+func Max[T cmp.Ordered](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Index returns the position of v in s, or -1. comparable allows == and !=.
+func Index[T comparable](s []T, v T) int {
+	for i, x := range s {
+		if x == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// Keys has two type parameters; K must be comparable because map keys are.
+func Keys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Zero has a type parameter that appears only in the result, so it can
+// never be inferred: callers must write Zero[int]().
+func Zero[T any]() T {
+	var zero T
+	return zero
+}
+
+func main() {
+	// -- 1. Type Parameters on Functions --
+	// [T constraint] after the name; T is then usable like any type in the signature and body.
+	fmt.Println("\n\033[1;36m=== 1. GENERIC FUNCTIONS ===\033[0m")
+	fmt.Println("Max(3, 7):", Max(3, 7), "| Max(2.5, 1.5):", Max(2.5, 1.5), `| Max("go", "gopher"):`, Max("go", "gopher"))
+	fmt.Println("Max[float64](3, 2.5):", Max[float64](3, 2.5), "(explicit instantiation: 3 becomes a float64)")
+	fmt.Println(`Index([]string{"a","b","c"}, "c"):`, Index([]string{"a", "b", "c"}, "c"), "| Index([]int{1,2}, 9):", Index([]int{1, 2}, 9))
+	keys := Keys(map[string]int{"b": 2, "a": 1, "c": 3})
+	slices.Sort(keys)
+	fmt.Println("Keys(map[string]int{...}) sorted:", keys, "(K = string, V = int, both inferred)")
+
+	maxInt := Max[int] // instantiating without calling gives an ordinary function value
+	fmt.Printf("maxInt := Max[int] has type %T\n", maxInt)
+	fmt.Println("One source, compiled per shape of T: no interface boxing, no type switches at run time.")
+
+	// -- 2. Constraints and Type Sets --
+	// A constraint is an interface; ~T means "any type whose underlying type is T" (see constraints.go)
+	fmt.Println("\n\033[1;36m=== 2. CONSTRAINTS AND ~TYPE SETS ===\033[0m")
+	constraintsSection()
+
+	// -- 3. The comparable Constraint --
+	// Everything == works on, with one run-time catch (see constraints.go)
+	fmt.Println("\n\033[1;36m=== 3. COMPARABLE ===\033[0m")
+	comparableSection()
+
+	// -- 4. Generic Types --
+	// Types with type parameters: Stack[T], Pair[K, V], and their methods (see stack.go)
+	fmt.Println("\n\033[1;36m=== 4. GENERIC TYPES ===\033[0m")
+	genericTypesSection()
+
+	// -- 5. No Generic Methods --
+	// Methods can't add type parameters of their own; use a function instead (see stack.go)
+	fmt.Println("\n\033[1;36m=== 5. GENERIC METHOD WORKAROUNDS ===\033[0m")
+	genericMethodsSection()
+
+	// -- 6. Type Inference and Its Limits --
+	// What the compiler can and can't work out on its own
+	fmt.Println("\n\033[1;36m=== 6. TYPE INFERENCE LIMITS ===\033[0m")
+	fmt.Println("Zero[int]():", Zero[int](), `| Zero[string](): "`+Zero[string]()+`"`, "| Zero[*int]():", Zero[*int]())
+	fmt.Println("  Zero() alone doesn't compile: T appears only in the result, nothing to infer it from.")
+	fmt.Println("Max(1, 2.5):", Max(1, 2.5), "(untyped constants 1 and 2.5 unify to float64)")
+	var n int = 1
+	fmt.Println("Max(n, 2):", Max(n, 2), "(typed n fixes T = int; the constant 2 fits)")
+	fmt.Println("  Max(n, 2.5) doesn't compile: 2.5 can't become an int.")
+	doubled := Map([]int{1, 2, 3}, func(x int) string { return strconv.Itoa(x * 2) })
+	fmt.Printf("Map([]int, func(int) string) infers T = int, U = string from the arguments: %q\n", doubled)
+	temps := []Celsius{21.5, 19, 25}
+	fmt.Printf("slices.Max([]Celsius) = %v %T: inference keeps the named type, it doesn't fall back to float64\n", slices.Max(temps), slices.Max(temps))
+
+	// -- 7. Map, Filter, Reduce --
+	// The functional trio, finally type-safe (see funcs.go)
+	fmt.Println("\n\033[1;36m=== 7. MAP / FILTER / REDUCE ===\033[0m")
+	functionalSection()
+
+	// -- 8. Generic vs interface{} --
+	// Same algorithms written both ways (see bench.go, timed in generics_test.go)
+	fmt.Println("\n\033[1;36m=== 8. GENERIC VS INTERFACE{} ===\033[0m")
+	benchmarkSection()
+}
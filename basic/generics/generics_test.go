@@ -0,0 +1,91 @@
+package main
+
+import "testing"
+
+// Generic vs interface{}: the same algorithms written both ways. Compare
+// each pair, with allocations, using:
+//
+//	go test -bench . -benchmem
+
+const benchSize = 1000
+
+// sink keeps the compiler from optimising the benchmarked work away. It's
+// an int, not an any, so storing a result doesn't allocate.
+var sink int
+
+// benchInts returns 0..benchSize-1 as plain ints and boxed in interfaces.
+func benchInts() ([]int, []any) {
+	ints := make([]int, benchSize)
+	boxed := make([]any, benchSize)
+	for i := range ints {
+		ints[i] = i
+		boxed[i] = i
+	}
+	return ints, boxed
+}
+
+func BenchmarkSum(b *testing.B) {
+	ints, _ := benchInts()
+	for b.Loop() {
+		sink = Sum(ints)
+	}
+}
+
+// BenchmarkSumAny pays a type assertion per element.
+func BenchmarkSumAny(b *testing.B) {
+	_, boxed := benchInts()
+	for b.Loop() {
+		sink = SumAny(boxed).(int)
+	}
+}
+
+func BenchmarkMax(b *testing.B) {
+	ints, _ := benchInts()
+	for b.Loop() {
+		m := ints[0]
+		for _, v := range ints {
+			m = Max(m, v)
+		}
+		sink = m
+	}
+}
+
+// BenchmarkMaxAny re-boxes the running max on every call.
+func BenchmarkMaxAny(b *testing.B) {
+	_, boxed := benchInts()
+	for b.Loop() {
+		var m any = boxed[0]
+		for _, v := range boxed {
+			m = maxAny(m, v)
+		}
+		sink = m.(int)
+	}
+}
+
+func BenchmarkStack(b *testing.B) {
+	var s Stack[int]
+	for b.Loop() {
+		for i := range benchSize {
+			s.Push(i + benchSize)
+		}
+		for range benchSize {
+			v, _ := s.Pop()
+			sink = v
+		}
+	}
+}
+
+// BenchmarkAnyStack boxes an int on the heap with each Push. Values from
+// 0 to 255 wouldn't allocate, so it pushes bigger ones.
+func BenchmarkAnyStack(b *testing.B) {
+	var s anyStack
+	for b.Loop() {
+		for i := range benchSize {
+			s.Push(i + benchSize)
+		}
+		for range benchSize {
+			v, _ := s.Pop()
+			sink = v.(int)
+		}
+	}
+}
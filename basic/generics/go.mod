@@ -0,0 +1,3 @@
+module golang/generics
+
+go 1.25.7
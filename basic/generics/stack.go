@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Stack is a last-in, first-out stack of any element type.
+type Stack[T any] struct {
+	items []T
+}
+
+// Methods repeat the type parameter list on the receiver, without the
+// constraint: (s *Stack[T]), not (s *Stack[T any]).
+func (s *Stack[T]) Push(v ...T) { s.items = append(s.items, v...) }
+
+// Pop removes and returns the top element. The zero value of T and false
+// mean the stack was empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+	v := s.items[len(s.items)-1]
+	s.items[len(s.items)-1] = zero // don't keep a reference to the popped value alive
+	s.items = s.items[:len(s.items)-1]
+	return v, true
+}
+
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+func (s *Stack[T]) Len() int { return len(s.items) }
+
+func (s *Stack[T]) String() string { return fmt.Sprintf("Stack%v", s.items) }
+
+// Pair holds two values of independent types.
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// Set is a map-backed set; the element type must be usable as a map key.
+type Set[T comparable] map[T]struct{}
+
+func NewSet[T comparable](items ...T) Set[T] {
+	s := Set[T]{}
+	for _, v := range items {
+		s[v] = struct{}{}
+	}
+	return s
+}
+
+func (s Set[T]) Has(v T) bool { _, ok := s[v]; return ok }
+
+// List is a singly linked list: generic types can refer to themselves.
+type List[T any] struct {
+	head *node[T]
+	size int
+}
+
+type node[T any] struct {
+	value T
+	next  *node[T]
+}
+
+func (l *List[T]) PushFront(v T) {
+	l.head = &node[T]{value: v, next: l.head}
+	l.size++
+}
+
+// All returns the values front to back as a range-over-func iterator.
+func (l *List[T]) All() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for n := l.head; n != nil; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// genericTypesSection uses each generic type with a couple of element types.
+func genericTypesSection() {
+	var ints Stack[int]
+	ints.Push(1, 2, 3)
+	top, _ := ints.Pop()
+	fmt.Println("Stack[int]: pushed 1, 2, 3 → Pop():", top, "| now:", ints.String(), "| Len:", ints.Len())
+
+	words := &Stack[string]{}
+	words.Push("go", "generics")
+	peek, _ := words.Peek()
+	fmt.Println("Stack[string]: Peek():", peek)
+
+	var empty Stack[float64]
+	v, ok := empty.Pop()
+	fmt.Println("Stack[float64]{}.Pop():", v, ok, "(zero value of T and false, no panic)")
+	fmt.Println("  ints.Push(\"x\") doesn't compile: a Stack[int] only takes ints. An []interface{} stack would take it and fail later.")
+
+	pairs := []Pair[string, int]{{"go", 2009}, {"generics", 2022}}
+	fmt.Printf("[]Pair[string, int]: %+v\n", pairs)
+
+	langs := NewSet("go", "rust", "zig")
+	fmt.Println(`Set[string]: Has("go"):`, langs.Has("go"), `| Has("cobol"):`, langs.Has("cobol"), "| NewSet infers T = string")
+
+	var list List[rune]
+	for _, r := range "olleh" {
+		list.PushFront(r)
+	}
+	var sb strings.Builder
+	for r := range list.All() { // Go 1.23 range-over-func
+		sb.WriteRune(r)
+	}
+	fmt.Println("List[rune] built front-first from \"olleh\", ranged with All():", sb.String(), "| size:", list.size)
+
+	fmt.Printf("Each instantiation is its own type: %T vs %T\n", ints, *words)
+}
+
+// MapStack is the workaround for a method Map[U] on Stack[T]: methods
+// can't introduce new type parameters, so it's a function instead.
+func MapStack[T, U any](s *Stack[T], f func(T) U) *Stack[U] {
+	out := &Stack[U]{items: make([]U, 0, len(s.items))}
+	for _, v := range s.items {
+		out.items = append(out.items, f(v))
+	}
+	return out
+}
+
+// Converter puts the second type parameter on the type, the other
+// workaround: every method can then use both T and U.
+type Converter[T, U any] struct {
+	convert func(T) U
+}
+
+func (c Converter[T, U]) Stack(s *Stack[T]) *Stack[U] { return MapStack(s, c.convert) }
+func (c Converter[T, U]) Slice(s []T) []U {
+	out := make([]U, len(s))
+	for i, v := range s {
+		out[i] = c.convert(v)
+	}
+	return out
+}
+
+// genericMethodsSection shows both ways around the missing generic methods.
+func genericMethodsSection() {
+	fmt.Println("Not allowed: func (s *Stack[T]) Map[U any](f func(T) U) *Stack[U]")
+	fmt.Println("  → syntax error: method must have no type parameters")
+
+	nums := &Stack[int]{}
+	nums.Push(1, 2, 3)
+	labels := MapStack(nums, func(n int) string { return strings.Repeat("*", n) })
+	fmt.Println("Workaround 1, a function: MapStack(Stack[int], func(int) string) →", labels)
+
+	toLen := Converter[string, int]{convert: func(s string) int { return len(s) }}
+	fmt.Println("Workaround 2, the type carries both parameters: Converter[string, int]")
+	fmt.Println("  .Stack(labels) →", toLen.Stack(labels), "| .Slice([]string{\"go\", \"gopher\"}) →", toLen.Slice([]string{"go", "gopher"}))
+
+	fmt.Println("Why: methods must be known when the type is instantiated, for interfaces and reflection.")
+	fmt.Println("That's also why the standard library writes slices.Index(s, v), not s.Index(v).")
+}
@@ -0,0 +1,421 @@
+// Command binsize shows which packages and functions a Go binary's bytes
+// are spent on, and what changed between two builds.
+//
+// Code is attributed with debug/gosym from .gopclntab, which even a
+// stripped binary keeps. Data (globals, tables) comes from the ELF symbol
+// table, so it's only counted when the binary wasn't built with -s.
+//
+// Usage:
+//
+//	go run . ../                         (build the hellobinary guide and rank its packages)
+//	go run . ../../datatypes             (any guide program: pass its directory)
+//	go run . -funcs 5 /path/to/binary    (an existing binary, with the top 5 functions per package)
+//	go run . old-binary new-binary       (what grew and what shrank between two builds)
+//	go run . -demo                       (what fmt costs compared to a println hello)
+package main
+
+import (
+	"debug/elf"
+	"debug/gosym"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const divider = "─────────────────────────────────────────────────────────────────────────────"
+
+// barWidth is how many columns the biggest package's bar fills.
+const barWidth = 30
+
+// Buckets for bytes that don't belong to a Go package.
+const (
+	typeBucket   = "(type descriptors)"
+	linkerBucket = "(linker-generated)"
+	otherBucket  = "(other)"
+)
+
+// symbol is one function or global and its size in bytes.
+type symbol struct {
+	name string
+	size uint64
+}
+
+// pkgSize is everything attributed to one package.
+type pkgSize struct {
+	name  string
+	code  uint64
+	data  uint64
+	funcs []symbol
+}
+
+func (p *pkgSize) total() uint64 { return p.code + p.data }
+
+// sizeReport is the attribution for one binary.
+type sizeReport struct {
+	path     string
+	fileSize int64
+	hasData  bool // false when the ELF symbol table was stripped
+	pkgs     map[string]*pkgSize
+}
+
+func main() {
+	top := flag.Int("top", 15, "number of packages to list")
+	funcs := flag.Int("funcs", 3, "number of largest functions to list under each package")
+	demo := flag.Bool("demo", false, "build a fmt hello and a println hello and compare them")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] binary-or-dir [other-binary-or-dir]\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	tmp, err := os.MkdirTemp("", "binsize")
+	if err != nil {
+		fail(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	var targets []string
+	switch {
+	case *demo:
+		targets, err = demoBinaries(tmp)
+	case flag.NArg() == 1 || flag.NArg() == 2:
+		for i, arg := range flag.Args() {
+			var path string
+			if path, err = binaryFor(arg, filepath.Join(tmp, fmt.Sprint("build", i))); err != nil {
+				break
+			}
+			targets = append(targets, path)
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fail(err)
+	}
+
+	var reports []*sizeReport
+	for _, t := range targets {
+		r, err := measure(t)
+		if err != nil {
+			fail(err)
+		}
+		reports = append(reports, r)
+	}
+
+	if len(reports) == 1 {
+		printRanking(reports[0], *top, *funcs)
+		return
+	}
+	printDiff(reports[0], reports[1], *top)
+}
+
+// binaryFor returns arg itself if it's a file, or builds the Go package
+// in the directory arg into out.
+func binaryFor(arg, out string) (string, error) {
+	info, err := os.Stat(arg)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return arg, nil
+	}
+	cmd := exec.Command("go", "build", "-o", out, ".")
+	cmd.Dir = arg
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("go build in %s failed: %w\n%s", arg, err, output)
+	}
+	return out, nil
+}
+
+// demoPrograms are two hellos that print the same line.
+var demoPrograms = []struct{ name, src string }{
+	{"hello-fmt", "package main\n\nimport \"fmt\"\n\nfunc main() { fmt.Println(\"Hello, Binary!\") }\n"},
+	{"hello-println", "package main\n\nfunc main() { println(\"Hello, Binary!\") }\n"},
+}
+
+// demoBinaries writes and builds the demo programs. They only use the
+// standard library, so this works offline.
+func demoBinaries(tmp string) ([]string, error) {
+	var paths []string
+	for _, p := range demoPrograms {
+		dir := filepath.Join(tmp, p.name)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module "+p.name+"\n"), 0o644); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte(p.src), 0o644); err != nil {
+			return nil, err
+		}
+		path, err := binaryFor(dir, filepath.Join(dir, p.name))
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	// The println build is the baseline, so the diff reads as "what fmt adds".
+	paths[0], paths[1] = paths[1], paths[0]
+	return paths, nil
+}
+
+// measure attributes the code and data of the ELF binary at path to
+// packages.
+func measure(path string) (*sizeReport, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := elf.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w (binsize reads ELF binaries: Linux and the BSDs)", path, err)
+	}
+	defer f.Close()
+
+	r := &sizeReport{path: path, fileSize: info.Size(), pkgs: map[string]*pkgSize{}}
+	pkg := func(name string) *pkgSize {
+		p, ok := r.pkgs[name]
+		if !ok {
+			p = &pkgSize{name: name}
+			r.pkgs[name] = p
+		}
+		return p
+	}
+
+	pclntab, text := f.Section(".gopclntab"), f.Section(".text")
+	if pclntab == nil || text == nil {
+		return nil, fmt.Errorf("%s has no .gopclntab: not a Go binary", path)
+	}
+	data, err := pclntab.Data()
+	if err != nil {
+		return nil, err
+	}
+	table, err := gosym.NewTable(nil, gosym.NewLineTable(data, text.Addr))
+	if err != nil {
+		return nil, fmt.Errorf("reading the Go symbol table of %s: %w", path, err)
+	}
+	for _, fn := range table.Funcs {
+		size := fn.End - fn.Entry
+		name := fn.PackageName()
+		if name == "" {
+			name = packageOf(fn.Name) // type:.eq.* and friends, generated by the compiler
+		}
+		p := pkg(name)
+		p.code += size
+		p.funcs = append(p.funcs, symbol{fn.Name, size})
+	}
+
+	// Type descriptors are one blob without per-type symbols.
+	if types := f.Section(".go.type"); types != nil {
+		pkg(typeBucket).data += types.Size
+	}
+
+	// Functions are already counted from .gopclntab; take only the data
+	// objects from the ELF symbols, and only those stored in the file:
+	// zeroed globals in .bss cost memory, not bytes on disk.
+	syms, err := f.Symbols()
+	if err == nil {
+		r.hasData = true
+		for _, s := range syms {
+			if elf.ST_TYPE(s.Info) != elf.STT_OBJECT || s.Size == 0 || int(s.Section) >= len(f.Sections) {
+				continue
+			}
+			if f.Sections[s.Section].Type == elf.SHT_NOBITS {
+				continue
+			}
+			pkg(packageOf(s.Name)).data += s.Size
+		}
+	}
+	return r, nil
+}
+
+// packageOf returns the package a data symbol belongs to, the way
+// gosym.Sym.PackageName does for functions.
+func packageOf(name string) string {
+	switch {
+	case strings.HasPrefix(name, "type:"):
+		return typeBucket
+	case strings.HasPrefix(name, "go:"):
+		return linkerBucket // go:func.*, go:string.*, go:itab.*...
+	}
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		name = name[:i] // generic instantiation: the type arguments can contain dots and slashes
+	}
+	pathEnd := max(strings.LastIndexByte(name, '/'), 0)
+	if i := strings.IndexByte(name[pathEnd:], '.'); i >= 0 {
+		return name[:pathEnd+i]
+	}
+	return otherBucket
+}
+
+// ranked returns the packages largest first.
+func (r *sizeReport) ranked() []*pkgSize {
+	var pkgs []*pkgSize
+	for _, p := range r.pkgs {
+		pkgs = append(pkgs, p)
+	}
+	sort.Slice(pkgs, func(i, j int) bool {
+		if pkgs[i].total() != pkgs[j].total() {
+			return pkgs[i].total() > pkgs[j].total()
+		}
+		return pkgs[i].name < pkgs[j].name
+	})
+	return pkgs
+}
+
+func (r *sizeReport) total() uint64 {
+	var sum uint64
+	for _, p := range r.pkgs {
+		sum += p.total()
+	}
+	return sum
+}
+
+// printRanking prints packages as a treemap laid out in one dimension:
+// each bar is proportional to its package, with its largest functions
+// nested underneath.
+func printRanking(r *sizeReport, top, funcs int) {
+	pkgs := r.ranked()
+	total := r.total()
+
+	fmt.Printf("\n\033[1;36m╔════════════════════════════════════════════════════════════════╗\033[0m\n")
+	fmt.Printf("\033[1;36m║              WHERE THE BINARY SIZE GOES (by package)           ║\033[0m\n")
+	fmt.Printf("\033[1;36m╚════════════════════════════════════════════════════════════════╝\033[0m\n")
+	fmt.Printf("Binary: %s (%s on disk)\n", r.path, formatSize(int64(r.fileSize)))
+	fmt.Printf("Attributed: %s in %d packages, %.0f%% of the file. The rest is headers, DWARF, symbol\n",
+		formatSize(int64(total)), len(pkgs), float64(total)*100/float64(r.fileSize))
+	fmt.Println("names and the runtime metadata (.gopclntab) that no single function owns.")
+	if !r.hasData {
+		fmt.Println("\033[33mNo ELF symbol table (built with -s): only code is counted, globals aren't.\033[0m")
+	}
+
+	fmt.Printf("\n\033[1;33m▶ PACKAGES\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-28s | %9s | %9s | %9s | %5s\n", "Package", "Code", "Data", "Total", "Share")
+	largest := pkgs[0].total()
+	for i, p := range pkgs {
+		if i == top {
+			var rest uint64
+			for _, q := range pkgs[top:] {
+				rest += q.total()
+			}
+			fmt.Printf("\033[90m%-28s | %9s | %9s | %9s | %4.1f%%\033[0m\n",
+				fmt.Sprintf("... %d more", len(pkgs)-top), "", "", formatSize(int64(rest)), float64(rest)*100/float64(total))
+			break
+		}
+		bar := strings.Repeat("█", max(1, int(p.total()*barWidth/largest)))
+		fmt.Printf("%-28s | %9s | %9s | %9s | %4.1f%% \033[36m%s\033[0m\n", truncate(p.name, 28),
+			formatSize(int64(p.code)), formatSize(int64(p.data)), formatSize(int64(p.total())),
+			float64(p.total())*100/float64(total), bar)
+
+		sort.Slice(p.funcs, func(i, j int) bool { return p.funcs[i].size > p.funcs[j].size })
+		for j, fn := range p.funcs[:min(funcs, len(p.funcs))] {
+			branch := "├─"
+			if j == min(funcs, len(p.funcs))-1 {
+				branch = "└─"
+			}
+			fmt.Printf("\033[90m  %s %-50s %9s\033[0m\n", branch, truncate(fn.name, 50), formatSize(int64(fn.size)))
+		}
+	}
+	fmt.Println()
+}
+
+// printDiff shows which packages grew or shrank from old to new, biggest
+// change first.
+func printDiff(old, new *sizeReport, top int) {
+	fmt.Printf("\n\033[1;36m╔════════════════════════════════════════════════════════════════╗\033[0m\n")
+	fmt.Printf("\033[1;36m║                 BINARY SIZE DIFF (by package)                  ║\033[0m\n")
+	fmt.Printf("\033[1;36m╚════════════════════════════════════════════════════════════════╝\033[0m\n")
+	fmt.Printf("Old: %s (%s)\n", old.path, formatSize(old.fileSize))
+	fmt.Printf("New: %s (%s)\n", new.path, formatSize(new.fileSize))
+	fmt.Printf("File size change: %s (%+.1f%%)\n", formatDelta(new.fileSize-old.fileSize),
+		float64(new.fileSize-old.fileSize)*100/float64(old.fileSize))
+
+	type change struct {
+		name     string
+		old, new int64
+	}
+	var changes []change
+	for name, p := range old.pkgs {
+		c := change{name: name, old: int64(p.total())}
+		if q, ok := new.pkgs[name]; ok {
+			c.new = int64(q.total())
+		}
+		changes = append(changes, c)
+	}
+	for name, q := range new.pkgs {
+		if _, ok := old.pkgs[name]; !ok {
+			changes = append(changes, change{name: name, new: int64(q.total())})
+		}
+	}
+	abs := func(n int64) int64 { return max(n, -n) }
+	sort.Slice(changes, func(i, j int) bool {
+		di, dj := abs(changes[i].new-changes[i].old), abs(changes[j].new-changes[j].old)
+		if di != dj {
+			return di > dj
+		}
+		return changes[i].name < changes[j].name
+	})
+
+	fmt.Printf("\n\033[1;33m▶ WHAT CHANGED\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-28s | %9s | %9s | %10s | %s\n", "Package", "Old", "New", "Change", "Note")
+	shown := 0
+	for _, c := range changes {
+		delta := c.new - c.old
+		if delta == 0 || shown == top {
+			continue
+		}
+		shown++
+		note, color := "", "\033[31m"
+		switch {
+		case c.old == 0:
+			note = "added"
+		case c.new == 0:
+			note = "removed"
+		}
+		if delta < 0 {
+			color = "\033[32m"
+		}
+		fmt.Printf("%-28s | %9s | %9s | %s%10s\033[0m | %s\n", truncate(c.name, 28),
+			formatSize(c.old), formatSize(c.new), color, formatDelta(delta), note)
+	}
+	if shown == 0 {
+		fmt.Println("No package changed size.")
+	}
+	fmt.Println()
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n-1] + "…"
+}
+
+func formatSize(n int64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%d B", n)
+}
+
+func formatDelta(n int64) string {
+	if n < 0 {
+		return "-" + formatSize(-n)
+	}
+	return "+" + formatSize(n)
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "\033[1;31m✗ %v\033[0m\n", err)
+	os.Exit(1)
+}
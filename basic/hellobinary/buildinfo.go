@@ -0,0 +1,202 @@
+package main
+
+import (
+	"debug/buildinfo"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"runtime/debug"
+	"strings"
+)
+
+// divider separates table headers from rows, like in the other guides.
+const divider = "─────────────────────────────────────────────────────────────────────────────"
+
+// keySettings are the build settings people ask about most. They're always
+// printed, with "(not set)" when the build didn't record them.
+var keySettings = []string{"GOOS", "GOARCH", "CGO_ENABLED", "-trimpath", "-ldflags", "-buildmode", "-compiler"}
+
+// Module is one module compiled into the binary.
+type Module struct {
+	Path    string `json:"path"`
+	Version string `json:"version"`
+	Sum     string `json:"sum,omitempty"`
+	Replace string `json:"replace,omitempty"`
+}
+
+// VCS is the version control state the go command stamped at build time.
+type VCS struct {
+	System   string `json:"system"`
+	Revision string `json:"revision"`
+	Time     string `json:"time,omitempty"`
+	Modified bool   `json:"modified"`
+}
+
+// Setting is one key=value build setting, kept in the order Go recorded it.
+type Setting struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// BuildReport is everything a Go binary knows about how it was built.
+type BuildReport struct {
+	Binary    string    `json:"binary"`
+	GoVersion string    `json:"go_version"`
+	Package   string    `json:"package"`
+	Main      Module    `json:"main_module"`
+	Deps      []Module  `json:"deps"`
+	VCS       *VCS      `json:"vcs,omitempty"`
+	Settings  []Setting `json:"settings"`
+}
+
+// loadBuildReport reads the build info embedded in the binary at path, or
+// in the running program itself when path is empty.
+func loadBuildReport(path string) (*BuildReport, error) {
+	var info *debug.BuildInfo
+	if path == "" {
+		var ok bool
+		if info, ok = debug.ReadBuildInfo(); !ok {
+			return nil, fmt.Errorf("no build info: the binary was built without module support")
+		}
+		if self, err := os.Executable(); err == nil {
+			path = self
+		}
+	} else {
+		var err error
+		if info, err = buildinfo.ReadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	r := &BuildReport{
+		Binary:    path,
+		GoVersion: info.GoVersion,
+		Package:   info.Path,
+		Main:      toModule(&info.Main),
+		Deps:      []Module{},
+	}
+	for _, dep := range info.Deps {
+		r.Deps = append(r.Deps, toModule(dep))
+	}
+
+	vcs := &VCS{}
+	for _, s := range info.Settings {
+		r.Settings = append(r.Settings, Setting{s.Key, s.Value})
+		switch s.Key {
+		case "vcs":
+			vcs.System = s.Value
+		case "vcs.revision":
+			vcs.Revision = s.Value
+		case "vcs.time":
+			vcs.Time = s.Value
+		case "vcs.modified":
+			vcs.Modified = s.Value == "true"
+		}
+	}
+	if vcs.System != "" {
+		r.VCS = vcs
+	}
+	return r, nil
+}
+
+func toModule(m *debug.Module) Module {
+	mod := Module{Path: m.Path, Version: m.Version, Sum: m.Sum}
+	if m.Replace != nil {
+		mod.Replace = m.Replace.Path + " " + m.Replace.Version
+	}
+	return mod
+}
+
+// setting returns the value of a build setting and whether it was recorded.
+func (r *BuildReport) setting(key string) (string, bool) {
+	for _, s := range r.Settings {
+		if s.Key == key {
+			return s.Value, true
+		}
+	}
+	return "", false
+}
+
+// trimpath reports whether the binary was built with -trimpath, which also
+// keeps -ldflags out of the recorded settings.
+func (r *BuildReport) trimpath() bool {
+	v, _ := r.setting("-trimpath")
+	return v == "true"
+}
+
+// writeJSON prints a report for scripts and CI checks.
+func writeJSON(w io.Writer, report any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+// printBuildReport prints the report as the guide's colored tables.
+func printBuildReport(r *BuildReport) {
+	fmt.Printf("\n\033[1;36m╔════════════════════════════════════════════════════════════════╗\033[0m\n")
+	fmt.Printf("\033[1;36m║             BUILD INFO (stamped in by the go command)          ║\033[0m\n")
+	fmt.Printf("\033[1;36m╚════════════════════════════════════════════════════════════════╝\033[0m\n")
+	fmt.Printf("Binary: %s\n", r.Binary)
+
+	fmt.Printf("\n\033[1;33m▶ WHAT WAS BUILT\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-15s | %s\n", "Go Version", r.GoVersion)
+	fmt.Printf("%-15s | %s\n", "Main Package", r.Package)
+	fmt.Printf("%-15s | %s\n", "Module", r.Main.Path)
+	version := r.Main.Version
+	if version == "(devel)" || version == "" {
+		version += " \033[90m(not built from a tagged module download)\033[0m"
+	}
+	fmt.Printf("%-15s | %s\n", "Module Version", version)
+
+	fmt.Printf("\n\033[1;35m▶ VERSION CONTROL\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	if r.VCS == nil {
+		fmt.Println("No VCS info. `go run` and builds outside a repository (or with -buildvcs=false) don't stamp it;")
+		fmt.Println("try `go build` inside the git checkout.")
+	} else {
+		dirty := "\033[32mno\033[0m"
+		if r.VCS.Modified {
+			dirty = "\033[31myes, built with uncommitted changes\033[0m"
+		}
+		fmt.Printf("%-15s | %s\n", "System", r.VCS.System)
+		fmt.Printf("%-15s | %s\n", "Revision", r.VCS.Revision)
+		fmt.Printf("%-15s | %s\n", "Commit Time", r.VCS.Time)
+		fmt.Printf("%-15s | %s\n", "Dirty", dirty)
+	}
+
+	fmt.Printf("\n\033[1;32m▶ BUILD SETTINGS\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	printed := map[string]bool{}
+	for _, key := range keySettings {
+		value, ok := r.setting(key)
+		switch {
+		case !ok && key == "-ldflags" && r.trimpath():
+			value = "\033[90m(not recorded: -trimpath leaves out -ldflags, which can contain paths)\033[0m"
+		case !ok:
+			value = "\033[90m(not set)\033[0m"
+		}
+		fmt.Printf("%-15s | %s\n", key, value)
+		printed[key] = true
+	}
+	for _, s := range r.Settings {
+		if !printed[s.Key] && !strings.HasPrefix(s.Key, "vcs") {
+			fmt.Printf("%-15s | %s\n", s.Key, s.Value)
+		}
+	}
+
+	fmt.Printf("\n\033[1;34m▶ DEPENDENCIES (%d)\033[0m\n", len(r.Deps))
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	if len(r.Deps) == 0 {
+		fmt.Println("None. Only the standard library, which ships with the toolchain and isn't listed.")
+	}
+	for _, d := range r.Deps {
+		line := fmt.Sprintf("%-40s | %s", d.Path, d.Version)
+		if d.Replace != "" {
+			line += " => " + d.Replace
+		}
+		fmt.Println(line)
+	}
+	fmt.Println()
+}
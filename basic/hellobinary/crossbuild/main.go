@@ -0,0 +1,254 @@
+// Command crossbuild builds hellobinary for every platform in a matrix,
+// records each artifact's size and SHA-256, then builds everything again
+// from an empty build cache and checks that the bytes come out the same.
+//
+// Only the local toolchain is used: GOTOOLCHAIN=local and GOPROXY=off
+// keep the go command from downloading anything, so it works offline.
+//
+// Usage:
+//
+//	go run .                                   (all targets into ../dist, then verify)
+//	go run . -targets linux/arm64,js/wasm      (just these)
+//	go run . -verify=false                     (build only)
+//	go run . -pkg ../../datatypes              (any other guide program)
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const divider = "─────────────────────────────────────────────────────────────────────────────"
+
+// defaultTargets are the GOOS/GOARCH pairs built when -targets isn't set.
+const defaultTargets = "linux/amd64,linux/arm64,windows/amd64,darwin/arm64,js/wasm,wasip1/wasm"
+
+// reproducibleFlags make two builds of the same source byte-identical:
+// -trimpath drops the local directory from file paths, an empty -buildid
+// drops the content hash the linker would otherwise stamp in, -buildvcs=false
+// leaves out the commit and dirty flag (which differ between a clean and an
+// edited checkout of the same source), and CGO_ENABLED=0 (set in the
+// environment) keeps the host C toolchain out.
+var reproducibleFlags = []string{"-trimpath", "-buildvcs=false", "-ldflags=-buildid="}
+
+// target is one GOOS/GOARCH pair and what building it produced.
+type target struct {
+	goos, goarch string
+	file         string // artifact path inside the dist directory
+	size         int64
+	sum          string
+	took         time.Duration
+}
+
+func (t *target) String() string { return t.goos + "/" + t.goarch }
+
+func main() {
+	pkg := flag.String("pkg", "..", "directory of the main package to build")
+	dist := flag.String("dist", "", "output directory (default <pkg>/dist)")
+	targetList := flag.String("targets", defaultTargets, "comma-separated GOOS/GOARCH pairs")
+	verify := flag.Bool("verify", true, "rebuild from an empty cache and compare hashes")
+	flag.Parse()
+
+	pkgDir, err := filepath.Abs(*pkg)
+	if err != nil {
+		fail(err)
+	}
+	if *dist == "" {
+		*dist = filepath.Join(pkgDir, "dist")
+	}
+	targets, err := parseTargets(*targetList, filepath.Base(pkgDir), *dist)
+	if err != nil {
+		fail(err)
+	}
+	if err := os.MkdirAll(*dist, 0o755); err != nil {
+		fail(err)
+	}
+
+	fmt.Printf("\n\033[1;36m╔════════════════════════════════════════════════════════════════╗\033[0m\n")
+	fmt.Printf("\033[1;36m║            CROSS-COMPILATION MATRIX (local toolchain)          ║\033[0m\n")
+	fmt.Printf("\033[1;36m╚════════════════════════════════════════════════════════════════╝\033[0m\n")
+	fmt.Printf("Package: %s\nOutput:  %s\nFlags:   CGO_ENABLED=0 go build %s\n", pkgDir, *dist, strings.Join(reproducibleFlags, " "))
+
+	fmt.Printf("\n\033[1;33m▶ BUILDS\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-14s | %-30s | %9s | %-16s | %s\n", "Target", "Artifact", "Size", "SHA-256", "Time")
+	for _, t := range targets {
+		if err := build(pkgDir, t, t.file, ""); err != nil {
+			fail(err)
+		}
+		fmt.Printf("%-14s | %-30s | %9s | %-16s | %s\n", t, filepath.Base(t.file), formatSize(t.size), t.sum[:16], t.took.Round(10*time.Millisecond))
+	}
+	if err := writeChecksums(*dist, targets); err != nil {
+		fail(err)
+	}
+	fmt.Printf("Checksums written to %s\n", filepath.Join(*dist, "SHA256SUMS"))
+
+	if !*verify {
+		fmt.Println()
+		return
+	}
+	if !verifyReproducible(pkgDir, targets) {
+		os.Exit(1)
+	}
+}
+
+// parseTargets turns "linux/amd64,js/wasm" into targets named after the
+// package, with the extension each platform expects.
+func parseTargets(list, name, dist string) ([]*target, error) {
+	var targets []*target
+	for _, pair := range strings.Split(list, ",") {
+		goos, goarch, ok := strings.Cut(strings.TrimSpace(pair), "/")
+		if !ok || goos == "" || goarch == "" {
+			return nil, fmt.Errorf("-targets: %q is not a GOOS/GOARCH pair", pair)
+		}
+		file := fmt.Sprintf("%s-%s-%s", name, goos, goarch)
+		switch {
+		case goos == "windows":
+			file += ".exe"
+		case goarch == "wasm":
+			file += ".wasm"
+		}
+		targets = append(targets, &target{goos: goos, goarch: goarch, file: filepath.Join(dist, file)})
+	}
+	return targets, nil
+}
+
+// build compiles the package for t into out and fills in its size and
+// hash. A non-empty cache directory replaces the go build cache.
+func build(pkgDir string, t *target, out, cache string) error {
+	args := append([]string{"build", "-o", out}, reproducibleFlags...)
+	cmd := exec.Command("go", append(args, ".")...)
+	cmd.Dir = pkgDir
+	cmd.Env = append(os.Environ(),
+		"GOOS="+t.goos, "GOARCH="+t.goarch, "CGO_ENABLED=0",
+		"GOTOOLCHAIN=local", "GOPROXY=off", "GOFLAGS=",
+	)
+	if cache != "" {
+		cmd.Env = append(cmd.Env, "GOCACHE="+cache)
+	}
+
+	start := time.Now()
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("building %s failed: %w\n%s", t, err, output)
+	}
+	t.took = time.Since(start)
+
+	size, sum, err := hashFile(out)
+	if err != nil {
+		return err
+	}
+	t.size, t.sum = size, sum
+	return nil
+}
+
+func hashFile(path string) (int64, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return 0, "", err
+	}
+	return n, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeChecksums writes SHA256SUMS in the format `sha256sum -c` checks.
+func writeChecksums(dist string, targets []*target) error {
+	var buf bytes.Buffer
+	for _, t := range targets {
+		fmt.Fprintf(&buf, "%s  %s\n", t.sum, filepath.Base(t.file))
+	}
+	return os.WriteFile(filepath.Join(dist, "SHA256SUMS"), buf.Bytes(), 0o644)
+}
+
+// verifyReproducible builds every target a second time into a scratch
+// directory, with a fresh build cache so nothing is reused from the first
+// pass, and compares hashes. On a mismatch it shows where the two
+// artifacts first differ.
+func verifyReproducible(pkgDir string, targets []*target) bool {
+	scratch, err := os.MkdirTemp("", "crossbuild")
+	if err != nil {
+		fail(err)
+	}
+	defer os.RemoveAll(scratch)
+	cache := filepath.Join(scratch, "gocache")
+
+	fmt.Printf("\n\033[1;35m▶ REPRODUCIBILITY (rebuilt from an empty cache)\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-14s | %-16s | %-16s | %s\n", "Target", "First Build", "Second Build", "Result")
+	same := 0
+	for _, t := range targets {
+		again := &target{goos: t.goos, goarch: t.goarch}
+		if err := build(pkgDir, again, filepath.Join(scratch, filepath.Base(t.file)), cache); err != nil {
+			fail(err)
+		}
+		result := "\033[32m✓ identical\033[0m"
+		if again.sum == t.sum {
+			same++
+		} else {
+			result = "\033[31m✗ differs\033[0m " + firstDifference(t.file, filepath.Join(scratch, filepath.Base(t.file)))
+		}
+		fmt.Printf("%-14s | %-16s | %-16s | %s\n", t, t.sum[:16], again.sum[:16], result)
+	}
+
+	if same == len(targets) {
+		fmt.Printf("\033[1;32m✓ All %d builds are reproducible: anyone with the same Go version and source gets these exact bytes.\033[0m\n\n", same)
+		return true
+	}
+	fmt.Printf("\033[1;31m✗ %d of %d builds differ between runs.\033[0m\n\n", len(targets)-same, len(targets))
+	return false
+}
+
+// firstDifference describes the first byte offset at which two files differ.
+func firstDifference(a, b string) string {
+	fa, err := os.Open(a)
+	if err != nil {
+		return err.Error()
+	}
+	defer fa.Close()
+	fb, err := os.Open(b)
+	if err != nil {
+		return err.Error()
+	}
+	defer fb.Close()
+
+	ra, rb := bufio.NewReader(fa), bufio.NewReader(fb)
+	for offset := 0; ; offset++ {
+		x, errA := ra.ReadByte()
+		y, errB := rb.ReadByte()
+		switch {
+		case errA != nil || errB != nil:
+			return fmt.Sprintf("(sizes differ, from byte %#x)", offset)
+		case x != y:
+			return fmt.Sprintf("(first at byte %#x)", offset)
+		}
+	}
+}
+
+func formatSize(n int64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%d B", n)
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "\033[1;31m✗ %v\033[0m\n", err)
+	os.Exit(1)
+}
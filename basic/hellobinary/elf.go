@@ -0,0 +1,279 @@
+package main
+
+import (
+	"debug/elf"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// goSections are the sections every Go executable has, with what the
+// runtime keeps in them.
+var goSections = []struct{ name, holds string }{
+	{".text", "machine code for every function"},
+	{".rodata", "constants and string literals"},
+	{".gopclntab", "PC-to-line table: stack traces, panics, GC stack maps"},
+	{".go.type", "type descriptors, for interfaces, maps and reflect"},
+	{".noptrdata", "initialized globals without pointers"},
+	{".data", "initialized globals with pointers"},
+	{".bss", "zeroed globals (only takes memory, not file space)"},
+	{".symtab", "linker symbols, for debuggers and profilers"},
+	{".debug_*", "DWARF debug info, for delve and gdb"},
+}
+
+// Section is one ELF section and what it costs. Size is what the section
+// takes in the file; a section compressed with SHF_COMPRESSED (DWARF, by
+// default) also records the size it expands to.
+type Section struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Size         uint64 `json:"size"`
+	Uncompressed uint64 `json:"uncompressed_size,omitempty"` // 0 unless the section is compressed
+	InFile       bool   `json:"in_file"`                     // false for .bss-like sections that only reserve memory
+}
+
+// ELFReport is the anatomy of one ELF executable.
+type ELFReport struct {
+	Binary        string    `json:"binary"`
+	FileSize      int64     `json:"file_size"`
+	Class         string    `json:"class"`
+	Machine       string    `json:"machine"`
+	Type          string    `json:"type"`
+	Sections      []Section `json:"sections"`
+	Symbols       int       `json:"symbols"`
+	FuncSymbols   int       `json:"func_symbols"`
+	ObjectSymbols int       `json:"object_symbols"`
+	DynSymbols    int       `json:"dynamic_symbols"`
+	DWARF         bool      `json:"dwarf"`
+	DWARFBytes    uint64    `json:"dwarf_bytes"`              // on disk
+	DWARFExpanded uint64    `json:"dwarf_uncompressed_bytes"` // after decompressing, as a debugger sees it
+}
+
+// loadELFReport opens the ELF file at path, or the running executable
+// when path is empty, and measures its sections and symbols.
+func loadELFReport(path string) (*ELFReport, error) {
+	if path == "" {
+		self, err := os.Executable()
+		if err != nil {
+			return nil, err
+		}
+		path = self
+	}
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := elf.Open(path)
+	if err != nil {
+		var formatErr *elf.FormatError
+		if errors.As(err, &formatErr) {
+			return nil, fmt.Errorf("%s is not an ELF file (debug/elf reads Linux and BSD binaries, not Mach-O or PE): %w", path, err)
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	r := &ELFReport{
+		Binary:   path,
+		FileSize: stat.Size(),
+		Class:    f.Class.String(),
+		Machine:  f.Machine.String(),
+		Type:     f.Type.String(),
+	}
+	for _, s := range f.Sections {
+		if s.Type == elf.SHT_NULL {
+			continue
+		}
+		// s.Size is the decompressed size; s.FileSize is what's on disk.
+		sec := Section{
+			Name:   s.Name,
+			Type:   s.Type.String(),
+			Size:   s.FileSize,
+			InFile: s.Type != elf.SHT_NOBITS,
+		}
+		if s.Flags&elf.SHF_COMPRESSED != 0 {
+			sec.Uncompressed = s.Size
+		}
+		r.Sections = append(r.Sections, sec)
+		if isDWARF(s.Name) {
+			r.DWARFBytes += s.FileSize
+			r.DWARFExpanded += s.Size
+		}
+	}
+
+	// Both return ErrNoSymbols when the table isn't there, which for a
+	// stripped or statically linked binary is the normal case, not an error.
+	if syms, err := f.Symbols(); err == nil {
+		r.Symbols = len(syms)
+		for _, s := range syms {
+			switch elf.ST_TYPE(s.Info) {
+			case elf.STT_FUNC:
+				r.FuncSymbols++
+			case elf.STT_OBJECT:
+				r.ObjectSymbols++
+			}
+		}
+	}
+	if dyn, err := f.DynamicSymbols(); err == nil {
+		r.DynSymbols = len(dyn)
+	}
+	_, err = f.DWARF()
+	r.DWARF = err == nil
+	return r, nil
+}
+
+func isDWARF(name string) bool {
+	return strings.HasPrefix(name, ".debug_") || strings.HasPrefix(name, ".zdebug_")
+}
+
+// section returns the size of the named section, or 0 if it isn't there.
+func (r *ELFReport) section(name string) uint64 {
+	for _, s := range r.Sections {
+		if s.Name == name {
+			return s.Size
+		}
+	}
+	return 0
+}
+
+// share is size as a percentage of the whole file.
+func (r *ELFReport) share(size uint64) float64 {
+	return float64(size) * 100 / float64(r.FileSize)
+}
+
+// printELFReport prints the anatomy as the guide's colored tables.
+func printELFReport(r *ELFReport) {
+	fmt.Printf("\n\033[1;36m╔════════════════════════════════════════════════════════════════╗\033[0m\n")
+	fmt.Printf("\033[1;36m║              ANATOMY OF A GO BINARY (debug/elf)                ║\033[0m\n")
+	fmt.Printf("\033[1;36m╚════════════════════════════════════════════════════════════════╝\033[0m\n")
+	fmt.Printf("Binary: %s\n", r.Binary)
+	fmt.Printf("%s, %s, %s, %s on disk\n", r.Class, r.Machine, r.Type, formatSize(uint64(r.FileSize)))
+
+	fmt.Printf("\n\033[1;33m▶ WHERE THE BYTES GO\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-12s | %10s | %6s | %s\n", "Section", "Size", "Share", "Holds")
+	for _, g := range goSections {
+		var size uint64
+		inFile := true
+		switch g.name {
+		case ".debug_*":
+			size = r.DWARFBytes
+		case ".bss":
+			size, inFile = r.section(g.name), false
+		default:
+			size = r.section(g.name)
+		}
+		share := fmt.Sprintf("%5.1f%%", r.share(size))
+		switch {
+		case size == 0:
+			share = "\033[90m  gone\033[0m"
+		case !inFile:
+			share = "\033[90m   n/a\033[0m"
+		}
+		fmt.Printf("%-12s | %10s | %s | %s\n", g.name, formatSize(size), share, g.holds)
+	}
+	fmt.Printf("Code (.text) is only part of it: the metadata that makes stack traces, GC and reflect work\n")
+	fmt.Printf("(.rodata + .gopclntab + .go.type) is %.0f%% of this file.\n", r.share(r.section(".rodata")+r.section(".gopclntab")+r.section(".go.type")))
+
+	fmt.Printf("\n\033[1;35m▶ ALL SECTIONS (%d)\033[0m\n", len(r.Sections))
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	fmt.Printf("%-22s | %-14s | %10s | %12s | %s\n", "Section", "Type", "Size", "Uncompressed", "Share")
+	for _, s := range r.Sections {
+		share := fmt.Sprintf("%5.1f%%", r.share(s.Size))
+		if !s.InFile {
+			share = "\033[90mmemory only\033[0m"
+		}
+		expanded := ""
+		if s.Uncompressed != 0 {
+			expanded = formatSize(s.Uncompressed)
+		}
+		fmt.Printf("%-22s | %-14s | %10s | %12s | %s\n", s.Name, s.Type, formatSize(s.Size), expanded, share)
+	}
+
+	fmt.Printf("\n\033[1;32m▶ SYMBOLS AND DEBUG INFO\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", divider)
+	if r.Symbols == 0 {
+		fmt.Printf("%-15s | %s\n", "Symbol Table", "\033[33mstripped\033[0m (built with -ldflags=-s)")
+	} else {
+		fmt.Printf("%-15s | %d symbols: %d functions, %d data objects\n", "Symbol Table", r.Symbols, r.FuncSymbols, r.ObjectSymbols)
+	}
+	fmt.Printf("%-15s | %d \033[90m(0 for a static binary that doesn't use cgo)\033[0m\n", "Dynamic Symbols", r.DynSymbols)
+	if r.DWARF && r.DWARFExpanded != r.DWARFBytes {
+		fmt.Printf("%-15s | %s in .debug_* sections, compressed from %s\n", "DWARF", formatSize(r.DWARFBytes), formatSize(r.DWARFExpanded))
+	} else if r.DWARF {
+		fmt.Printf("%-15s | %s in .debug_* sections\n", "DWARF", formatSize(r.DWARFBytes))
+	} else {
+		fmt.Printf("%-15s | %s\n", "DWARF", "\033[33mnone\033[0m (built with -ldflags=-w, or -s which implies it)")
+	}
+	fmt.Println("Stripping never removes .gopclntab: the runtime itself reads it, so panics still show function names.")
+	fmt.Println()
+}
+
+// buildVariant is one way of building the same package.
+type buildVariant struct {
+	name  string
+	flags []string
+}
+
+var buildVariants = []buildVariant{
+	{"go build", nil},
+	{"-trimpath", []string{"-trimpath"}},
+	{`-ldflags="-w"`, []string{"-ldflags=-w"}},
+	{`-ldflags="-s -w"`, []string{"-ldflags=-s -w"}},
+	{`-s -w -trimpath`, []string{"-trimpath", "-ldflags=-s -w"}},
+}
+
+// compareBuildFlags builds the package in dir once per variant into a
+// temporary directory and shows how each flag changes the anatomy.
+func compareBuildFlags(w io.Writer, dir string) error {
+	tmp, err := os.MkdirTemp("", "hellobinary-variants")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	fmt.Fprintf(w, "\n\033[1;34m▶ WHAT THE BUILD FLAGS CHANGE\033[0m\n")
+	fmt.Fprintf(w, "\033[90m%s\033[0m\n", divider)
+	fmt.Fprintf(w, "%-18s | %9s | %6s | %9s | %9s | %10s | %9s | %s\n", "Build", "File", "Change", ".text", ".rodata", ".gopclntab", "DWARF", "Symbols")
+	var base int64
+	for i, v := range buildVariants {
+		out := filepath.Join(tmp, fmt.Sprintf("variant%d", i))
+		args := append([]string{"build", "-o", out}, v.flags...)
+		cmd := exec.Command("go", append(args, ".")...)
+		cmd.Dir = dir
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("go build %s in %s failed: %w\n%s", strings.Join(v.flags, " "), dir, err, output)
+		}
+		r, err := loadELFReport(out)
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			base = r.FileSize
+		}
+		change := float64(r.FileSize-base) * 100 / float64(base)
+		fmt.Fprintf(w, "%-18s | %9s | %5.0f%% | %9s | %9s | %10s | %9s | %d\n", v.name, formatSize(uint64(r.FileSize)), change,
+			formatSize(r.section(".text")), formatSize(r.section(".rodata")), formatSize(r.section(".gopclntab")),
+			formatSize(r.DWARFBytes), r.Symbols)
+	}
+	fmt.Fprintln(w, "  -w        drops the DWARF sections: debuggers lose variables and types, stack traces don't change.")
+	fmt.Fprintln(w, "  -s        drops the symbol table too (and implies -w): nm and objdump go blind, profiles still work.")
+	fmt.Fprintln(w, "  -trimpath barely changes the size: it rewrites /home/you/... file paths in .gopclntab to module")
+	fmt.Fprintln(w, "            paths, so the binary doesn't leak your directory layout and builds are reproducible.")
+	fmt.Fprintln(w, "  .text is the same size in every row: none of these flags change the code that runs.")
+	return nil
+}
+
+func formatSize(n uint64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%d B", n)
+}
@@ -1,7 +1,76 @@
+// Command hellobinary says hello, then describes the binary it's running
+// from: how it was built, from what, and what it's made of.
+//
+// Usage:
+//
+//	go build && ./hellobinary          (build info of this binary)
+//	./hellobinary -json                (the same, as JSON)
+//	./hellobinary /path/to/any/go/binary
+//	./hellobinary -elf [binary]        (sections, symbols and DWARF of an ELF file)
+//	./hellobinary -elf -compare        (rebuild this module with -s -w and -trimpath and compare)
 package main
 
-import "fmt"
+import (
+	"flag"
+	"fmt"
+	"os"
+)
 
 func main() {
+	asJSON := flag.Bool("json", false, "print the report as JSON")
+	showELF := flag.Bool("elf", false, "show the ELF anatomy (sections, symbols, DWARF) instead of the build info")
+	compare := flag.Bool("compare", false, "with -elf, also rebuild the module in the current directory with different flags and compare")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [binary]\n\nWith no binary, describes itself.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	target := flag.Arg(0)
+
+	if *showELF {
+		runELF(target, *asJSON, *compare)
+		return
+	}
+
+	report, err := loadBuildReport(target)
+	if err != nil {
+		fail(err)
+	}
+	if *asJSON {
+		if err := writeJSON(os.Stdout, report); err != nil {
+			fail(err)
+		}
+		return
+	}
+
 	fmt.Println("Hello, Binary!")
-}
\ No newline at end of file
+	printBuildReport(report)
+}
+
+func runELF(target string, asJSON, compare bool) {
+	report, err := loadELFReport(target)
+	if err != nil {
+		fail(err)
+	}
+	if asJSON {
+		if err := writeJSON(os.Stdout, report); err != nil {
+			fail(err)
+		}
+		return
+	}
+
+	fmt.Println("Hello, Binary!")
+	printELFReport(report)
+	if !compare {
+		fmt.Println("Tip: run with -elf -compare from this directory to see what -s -w and -trimpath strip out.")
+		return
+	}
+	if err := compareBuildFlags(os.Stdout, "."); err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "\033[1;31m✗ %v\033[0m\n", err)
+	os.Exit(1)
+}
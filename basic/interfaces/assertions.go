@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+)
+
+// describe uses a type switch to handle each concrete type differently.
+func describe(v any) string {
+	switch x := v.(type) { // x has the case's type inside each case
+	case nil:
+		return "nil"
+	case int, int64: // several types in one case: x stays an any
+		return fmt.Sprintf("an integer %v", x)
+	case string:
+		return fmt.Sprintf("a string of %d bytes", len(x))
+	case error: // interface cases match anything implementing them
+		return "an error: " + x.Error()
+	case fmt.Stringer:
+		return "a Stringer: " + x.String()
+	case Shape:
+		return fmt.Sprintf("a Shape with area %.2f", x.Area())
+	case []int:
+		return fmt.Sprintf("a []int of length %d", len(x))
+	default:
+		return fmt.Sprintf("something else (%T)", x)
+	}
+}
+
+// assertionsSection shows both assertion forms and the type switch.
+func assertionsSection() {
+	var s Shape = Rect{W: 2, H: 5}
+
+	r := s.(Rect) // single-value form: panics if wrong
+	fmt.Println("s.(Rect):", r, "| r.W:", r.W)
+
+	if c, ok := s.(Circle); ok { // comma-ok form: never panics
+		fmt.Println("It's a circle with radius", c.R)
+	} else {
+		fmt.Println("s.(Circle) with comma-ok: ok =", ok, "| c =", c, "(zero value)")
+	}
+
+	func() {
+		defer func() { fmt.Println("s.(Circle) without ok: \033[31mpanic:\033[0m", recover()) }()
+		_ = s.(Circle)
+	}()
+
+	var w io.Writer = os.Stdout
+	if _, ok := w.(io.ReaderFrom); ok { // asserting to another interface: "does it also have...?"
+		fmt.Println("os.Stdout (as io.Writer) also implements io.ReaderFrom: io.Copy can use its fast path")
+	}
+
+	fmt.Println("\nType switch over mixed values:")
+	values := []any{42, "gopher", errors.New("disk full"), Circle{R: 1}, []int{1, 2}, 3.14, nil, Counter{}}
+	for _, v := range values {
+		fmt.Printf("  %-20T → %s\n", v, describe(v))
+	}
+	fmt.Println("Order matters: cases are tried top to bottom, and an interface case catches every type that fits.")
+}
+
+// anySection compares interface{} and any, and shows what they cost.
+func anySection() {
+	var a any = 1
+	var e interface{} = 1
+	same := reflect.TypeOf(&a).Elem() == reflect.TypeOf(&e).Elem()
+	fmt.Println("any and interface{} are the same type:", same, "(any is an alias since Go 1.18)")
+
+	box := []any{1, "two", 3.0, []string{"four"}, map[string]int{"five": 5}, Rect{1, 1}}
+	fmt.Print("A []any holds anything: ")
+	for _, v := range box {
+		fmt.Printf("%T ", v)
+	}
+	fmt.Println()
+
+	total := 0
+	for _, v := range []any{1, "2", 3.0, "four"} { // the price: every use needs a check
+		switch x := v.(type) {
+		case int:
+			total += x
+		case float64:
+			total += int(x)
+		case string:
+			if n, err := strconv.Atoi(x); err == nil {
+				total += n
+			}
+		}
+	}
+	fmt.Println("Summing []any{1, \"2\", 3.0, \"four\"} takes a type switch per element:", total)
+
+	var x, y any = 1, 1.0
+	fmt.Println("any(1) == any(1.0):", x == y, "(same value, different dynamic types)")
+	func() {
+		defer func() { fmt.Println("any([]int{}) == any([]int{}): \033[31mpanic:\033[0m", recover()) }()
+		var p, q any = []int{}, []int{}
+		fmt.Println(p == q)
+	}()
+
+	fmt.Println("Use any at real boundaries (fmt.Println, JSON, containers before generics).")
+	fmt.Println("Inside your own code, a small interface or a type parameter says more and checks at compile time.")
+	fmt.Println(`Proverb: "interface{} says nothing."`)
+}
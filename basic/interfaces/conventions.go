@@ -0,0 +1,91 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Temperature implements fmt.Stringer, so every fmt verb that prints a
+// value (%v, %s, Println) uses String instead of the raw number.
+type Temperature float64
+
+func (t Temperature) String() string { return fmt.Sprintf("%.1f°C", float64(t)) }
+
+// ValidationError is a custom error type: it implements error and
+// carries fields the caller can inspect with errors.As.
+type ValidationError struct {
+	Field string
+	Value any
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %v", e.Field, e.Value)
+}
+
+func setAge(age int) error {
+	if age < 0 || age > 150 {
+		return &ValidationError{Field: "age", Value: age}
+	}
+	return nil
+}
+
+// rot13Reader wraps another io.Reader and decodes ROT13 on the way
+// through. Read's contract: fill p, return how many bytes, io.EOF at the end.
+type rot13Reader struct{ r io.Reader }
+
+func (rr rot13Reader) Read(p []byte) (int, error) {
+	n, err := rr.r.Read(p)
+	for i := range p[:n] {
+		switch c := p[i]; {
+		case c >= 'a' && c <= 'z':
+			p[i] = 'a' + (c-'a'+13)%26
+		case c >= 'A' && c <= 'Z':
+			p[i] = 'A' + (c-'A'+13)%26
+		}
+	}
+	return n, err
+}
+
+// countingReader counts the bytes and calls that pass through it.
+type countingReader struct {
+	r            io.Reader
+	bytes, calls int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.bytes += n
+	c.calls++
+	return n, err
+}
+
+// conventionsSection implements the standard library's three most
+// common interfaces and hands the results to code that only knows the
+// interface.
+func conventionsSection() {
+	t := Temperature(21.5)
+	fmt.Println("fmt.Stringer: Println(t) →", t, "| Sprintf(\"%v\") →", fmt.Sprintf("%v", t), "| %.2f still sees the number:", fmt.Sprintf("%.2f", float64(t)))
+	fmt.Println("  Slices use it too:", []Temperature{18, 25.5})
+
+	err := setAge(200)
+	fmt.Println("\nerror: setAge(200) →", err)
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		fmt.Printf("  errors.As found *ValidationError{Field: %q, Value: %v}\n", ve.Field, ve.Value)
+	}
+	fmt.Println("  setAge(30) == nil:", setAge(30) == nil)
+	fmt.Println("  Return the error interface, not *ValidationError: a nil *ValidationError in an error is not nil!")
+
+	fmt.Println("\nio.Reader: one method, Read(p []byte) (n int, err error), composes with everything:")
+	src := &countingReader{r: rot13Reader{strings.NewReader("Tb vf sha! Vagresnprf ner fznyy.")}}
+	var out strings.Builder
+	n, err := io.Copy(&out, src) // io.Copy knows nothing about rot13 or counting
+	fmt.Printf("  io.Copy(builder, counting(rot13(strings))) → %q, %d bytes, err %v\n", out.String(), n, err)
+	fmt.Printf("  The countingReader saw %d bytes in %d Read calls (the last one returned io.EOF)\n", src.bytes, src.calls)
+
+	first, _ := io.ReadAll(io.LimitReader(rot13Reader{strings.NewReader("Uryyb, Tbcure")}, 5))
+	fmt.Printf("  io.LimitReader(rot13, 5) → %q: standard wrappers stack on your reader for free\n", first)
+	fmt.Println("Small interfaces are the Go way: io.Reader, io.Writer, fmt.Stringer and error each have one method.")
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Animal is embedded in Dog below; its fields and methods get promoted.
+type Animal struct {
+	Name string
+	Legs int
+}
+
+func (a Animal) Describe() string { return fmt.Sprintf("%s has %d legs", a.Name, a.Legs) }
+func (a Animal) Sound() string    { return "..." }
+
+// Dog embeds Animal: no field name, just the type. Dog.Name and
+// Dog.Describe() are Animal's, reached without writing d.Animal.
+type Dog struct {
+	Animal
+	Breed string
+}
+
+// Sound shadows the promoted Animal.Sound. The outer method wins; the
+// inner one is still reachable as d.Animal.Sound().
+func (d Dog) Sound() string { return "Woof" }
+
+// Speaker is satisfied by Dog through its own Sound, and by Animal.
+type Speaker interface{ Sound() string }
+
+// upperWriter embeds an io.Writer interface, so whatever it wraps is
+// reachable as u.Writer. io.Writer has only one method, so overriding
+// Write replaces all of it; embedding a bigger interface, like
+// io.ReadWriter, would keep the Read it doesn't override.
+type upperWriter struct {
+	io.Writer
+	written int // bytes passed on to the wrapped writer
+}
+
+// Write reports len(p) on success, not the size of the upper-cased copy:
+// callers count the bytes they handed in, and upper-casing can change the
+// length (like "ı" becoming "I").
+func (u *upperWriter) Write(p []byte) (int, error) {
+	n, err := u.Writer.Write([]byte(strings.ToUpper(string(p))))
+	u.written += n
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// embeddingSection shows struct embedding, method promotion and
+// shadowing, and interface embedding.
+func embeddingSection() {
+	d := Dog{Animal: Animal{Name: "Rex", Legs: 4}, Breed: "Beagle"}
+	fmt.Println("d.Name (promoted field):", d.Name, "| d.Breed:", d.Breed)
+	fmt.Println("d.Describe() (promoted method):", d.Describe())
+	fmt.Println("d.Sound() (Dog's own, shadows Animal's):", d.Sound(), "| d.Animal.Sound():", d.Animal.Sound())
+
+	for _, sp := range []Speaker{d, d.Animal} {
+		fmt.Printf("  Speaker %-12T says %q\n", sp, sp.Sound())
+	}
+	fmt.Println("Embedding is not inheritance: a Dog isn't an Animal, so func(a Animal) won't take a Dog.")
+	fmt.Println("You pass d.Animal explicitly. Promotion is only a shortcut for the selector.")
+
+	fmt.Println("\nInterface embedding builds bigger interfaces from small ones:")
+	fmt.Println("  type ReadWriter interface { Reader; Writer }  // io.ReadWriter")
+	var rw io.ReadWriter = &bytes.Buffer{} // has both Read and Write
+	fmt.Fprint(rw, "round trip")
+	back, _ := io.ReadAll(rw)
+	fmt.Printf("  *bytes.Buffer as an io.ReadWriter: wrote and read back %q\n", back)
+	_, isRW := any(&strings.Builder{}).(io.ReadWriter)
+	fmt.Println("  *strings.Builder is an io.ReadWriter?", isRW, "(it can Write but not Read)")
+
+	var out strings.Builder
+	w := &upperWriter{Writer: &out}
+	n, _ := fmt.Fprintf(w, "embedded interface %s, ıi", "field")
+	fmt.Printf("Struct embedding an io.Writer, overriding Write: %q\n", out.String())
+	fmt.Printf("  Write reported %d bytes (what the caller gave it), the wrapped writer got %d: ı is 2 bytes, I is 1\n", n, w.written)
+}
@@ -0,0 +1,3 @@
+module golang/interfaces
+
+go 1.25.7
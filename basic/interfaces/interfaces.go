@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Shape is satisfied by any type with these two methods. Nothing has to
+// declare that it implements Shape.
+type Shape interface {
+	Area() float64
+	Perimeter() float64
+}
+
+// Rect and Circle use value receivers: the methods only read the value,
+// so both Rect and *Rect have them.
+type Rect struct{ W, H float64 }
+
+func (r Rect) Area() float64      { return r.W * r.H }
+func (r Rect) Perimeter() float64 { return 2 * (r.W + r.H) }
+
+type Circle struct{ R float64 }
+
+func (c Circle) Area() float64      { return math.Pi * c.R * c.R }
+func (c Circle) Perimeter() float64 { return 2 * math.Pi * c.R }
+
+// Counter uses a pointer receiver for Inc because Inc changes it. That
+// puts Inc in the method set of *Counter only.
+type Counter struct{ N int }
+
+func (c *Counter) Inc()      { c.N++ }
+func (c Counter) Value() int { return c.N }
+
+// Incrementer is satisfied by *Counter but not by Counter.
+type Incrementer interface{ Inc() }
+
+// Compile-time checks: the build fails if the types stop satisfying the
+// interfaces. They cost nothing at run time.
+var (
+	_ Shape       = Rect{}
+	_ Shape       = Circle{}
+	_ Incrementer = (*Counter)(nil)
+	// _ Incrementer = Counter{} // error: Counter does not implement Incrementer (method Inc has pointer receiver)
+)
+
+func totalArea(shapes ...Shape) float64 {
+	total := 0.0
+	for _, s := range shapes {
+		total += s.Area()
+	}
+	return total
+}
+
+// methodNames lists the method set of t's type as reflect sees it.
+func methodNames(v any) []string {
+	t := reflect.TypeOf(v)
+	names := make([]string, t.NumMethod())
+	for i := range names {
+		names[i] = t.Method(i).Name
+	}
+	return names
+}
+
+func main() {
+	// -- 1. Receivers and Method Sets --
+	// Value receivers work on a copy; pointer receivers can change the original.
+	fmt.Println("\n\033[1;36m=== 1. RECEIVERS AND METHOD SETS ===\033[0m")
+	c := Counter{}
+	c.Inc() // Go takes &c for you because c is addressable
+	c.Inc()
+	fmt.Println("c.Inc() twice on an addressable Counter:", c.Value(), "(Go wrote (&c).Inc() for you)")
+
+	fmt.Printf("Method set of %-10s %v\n", "Counter:", methodNames(Counter{}))
+	fmt.Printf("Method set of %-10s %v\n", "*Counter:", methodNames(&Counter{}))
+	fmt.Printf("Method set of %-10s %v\n", "Rect:", methodNames(Rect{}))
+	fmt.Printf("Method set of %-10s %v\n", "*Rect:", methodNames(&Rect{}))
+	fmt.Println("Rule: T has the value-receiver methods; *T has both. So only *Counter is an Incrementer.")
+
+	var inc Incrementer = &c
+	inc.Inc()
+	fmt.Println("var inc Incrementer = &c; inc.Inc() →", c.Value())
+	fmt.Println("Why not Counter{}? An interface holds a copy; Inc would change the copy, never your c.")
+
+	// -- 2. Implicit Satisfaction --
+	// No "implements" keyword: having the methods is enough.
+	fmt.Println("\n\033[1;36m=== 2. IMPLICIT INTERFACES ===\033[0m")
+	shapes := []Shape{Rect{W: 3, H: 4}, Circle{R: 1}, &Rect{W: 1, H: 1}}
+	for _, s := range shapes {
+		fmt.Printf("  %-14T area %6.2f  perimeter %6.2f\n", s, s.Area(), s.Perimeter())
+	}
+	fmt.Printf("totalArea(shapes...) = %.2f\n", totalArea(shapes...))
+
+	var s Shape
+	fmt.Printf("Zero value of an interface: %v | s == nil: %v\n", s, s == nil)
+	s = Circle{R: 2}
+	fmt.Printf("After s = Circle{2}: dynamic type %T, dynamic value %v\n", s, s)
+	fmt.Println("An interface value is a pair (type, value): 16 bytes on 64-bit systems.")
+
+	// -- 3. Embedding and Promotion --
+	// Embedded fields lend their methods to the outer type (see embedding.go)
+	fmt.Println("\n\033[1;36m=== 3. EMBEDDING AND PROMOTION ===\033[0m")
+	embeddingSection()
+
+	// -- 4. Type Assertions and Type Switches --
+	// Get the concrete type back out of an interface (see assertions.go)
+	fmt.Println("\n\033[1;36m=== 4. TYPE ASSERTIONS AND SWITCHES ===\033[0m")
+	assertionsSection()
+
+	// -- 5. Empty Interface vs any --
+	// interface{} accepts everything; any is the same type under a shorter name (see assertions.go)
+	fmt.Println("\n\033[1;36m=== 5. EMPTY INTERFACE VS ANY ===\033[0m")
+	anySection()
+
+	// -- 6. Standard Interfaces --
+	// fmt.Stringer, error and io.Reader: the three you'll implement most (see conventions.go)
+	fmt.Println("\n\033[1;36m=== 6. STRINGER, ERROR, IO.READER ===\033[0m")
+	conventionsSection()
+
+	// -- 7. Person, Sorted --
+	// Person implements fmt.Stringer; People implements sort.Interface (see people.go)
+	fmt.Println("\n\033[1;36m=== 7. PERSON: STRINGER AND SORT.INTERFACE ===\033[0m")
+	peopleSection()
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Address and Person are the datastructures guide's types, now with
+// methods.
+type Address struct {
+	Street string
+	City   string
+	Zip    int
+}
+
+type Person struct {
+	Name    string
+	Age     int
+	Address Address
+}
+
+// String makes Person a fmt.Stringer. Value receiver, so both Person and
+// *Person print nicely.
+func (p Person) String() string {
+	return fmt.Sprintf("%s (%d, %s)", p.Name, p.Age, p.Address.City)
+}
+
+// People implements sort.Interface: Len, Less and Swap are all sort.Sort
+// needs to know about the data.
+type People []Person
+
+func (ps People) Len() int           { return len(ps) }
+func (ps People) Less(i, j int) bool { return ps[i].Age < ps[j].Age }
+func (ps People) Swap(i, j int)      { ps[i], ps[j] = ps[j], ps[i] }
+
+// ByName embeds People and overrides only Less: Len and Swap are promoted.
+type ByName struct{ People }
+
+func (b ByName) Less(i, j int) bool { return b.People[i].Name < b.People[j].Name }
+
+// ByCity sorts by city, then by name within a city.
+type ByCity struct{ People }
+
+func (b ByCity) Less(i, j int) bool {
+	x, y := b.People[i], b.People[j]
+	if x.Address.City != y.Address.City {
+		return x.Address.City < y.Address.City
+	}
+	return x.Name < y.Name
+}
+
+var _ sort.Interface = People(nil)
+var _ fmt.Stringer = Person{}
+
+func peopleSection() {
+	people := People{
+		{Name: "John Doe", Age: 31, Address: Address{"123 Main St", "Anytown", 54321}},
+		{Name: "Ada", Age: 36, Address: Address{"12 St James's Sq", "London", 10001}},
+		{Name: "Grace", Age: 45, Address: Address{"1 Navy Yard", "Arlington", 22202}},
+		{Name: "Linus", Age: 21, Address: Address{"5 Kaivokatu", "Helsinki", 100}},
+		{Name: "Alan", Age: 41, Address: Address{"9 Wilmslow Rd", "London", 20002}},
+	}
+
+	fmt.Println("Println(people[0]) uses Person.String:", people[0])
+	fmt.Printf("%%v of &people[1] (a *Person) uses it too: %v\n", &people[1])
+	fmt.Printf("%%+v calls String too, so no field names: %+v\n", people[2])
+	type plain Person // a new type with the same fields but no methods
+	fmt.Printf("Converted to a type without String: %+v\n", plain(people[3]))
+
+	show := func(label string) {
+		fmt.Printf("  %-26s", label)
+		for _, p := range people {
+			fmt.Printf(" %s(%d)", p.Name, p.Age)
+		}
+		fmt.Println()
+	}
+	show("Original:")
+	sort.Sort(people)
+	show("sort.Sort(people) by age:")
+	sort.Sort(sort.Reverse(people))
+	show("sort.Reverse(people):")
+	sort.Sort(ByName{people})
+	show("sort.Sort(ByName{people}):")
+	sort.Stable(ByCity{people})
+	fmt.Printf("  %-26s", "sort.Stable(ByCity{...}):")
+	for _, p := range people {
+		fmt.Printf(" %s/%s", p.Address.City, p.Name)
+	}
+	fmt.Println()
+
+	fmt.Println("sort.Reverse is itself a sort.Interface wrapping yours: it only flips Less.")
+	fmt.Println("ByName and ByCity embed People and override just Less; Len and Swap are promoted.")
+	fmt.Println("Newer code often reaches for slices.SortFunc instead, but the interface shows the pattern.")
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// safeCall runs op and turns a runtime panic into a printable message, so the
+// guide can show operations that crash without crashing itself.
+func safeCall(op func() string) (result string) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = fmt.Sprintf("\033[31mpanic: %v\033[0m", r)
+		}
+	}()
+	return op()
+}
+
+// divisionEdgeCases prints how /, % and the shift operators behave once the
+// operands stop being friendly positive numbers like a=20, b=8.
+func divisionEdgeCases() {
+	fmt.Println("\nTruncated Division & Modulus (every sign combination):")
+	fmt.Println("  Go rounds the quotient toward zero, so the remainder takes the sign of a.")
+	fmt.Printf("  %6s | %6s | %7s | %7s | %s\n", "a", "b", "a / b", "a % b", "(a/b)*b + a%b == a")
+	for _, a := range []int{7, -7} {
+		for _, b := range []int{2, -2} {
+			q, r := a/b, a%b
+			fmt.Printf("  %6d | %6d | %7d | %7d | %v\n", a, b, q, r, q*b+r == a)
+		}
+	}
+
+	fmt.Println("\nDivision by Zero:")
+	zero := 0 // a variable, because a constant 0 divisor is a compile-time error
+	fmt.Printf("  10 / zero (int)      → %s\n", safeCall(func() string { return fmt.Sprint(10 / zero) }))
+	fmt.Printf("  10 %% zero (int)      → %s\n", safeCall(func() string { return fmt.Sprint(10 % zero) }))
+	fZero := 0.0
+	fmt.Printf("  1.0 / 0.0 (float64)  → %v\n", 1.0/fZero)
+	fmt.Printf("  -1.0 / 0.0 (float64) → %v\n", -1.0/fZero)
+	fmt.Printf("  0.0 / 0.0 (float64)  → %v (floats never panic, they return ±Inf or NaN)\n", fZero/fZero)
+
+	fmt.Println("\nThe One Overflowing Division (MinInt64 / -1):")
+	minInt := int64(math.MinInt64)
+	minusOne := int64(-1)
+	fmt.Printf("  math.MinInt64 / -1 = %d (the true answer 2^63 doesn't fit, so it wraps back to itself)\n", minInt/minusOne)
+	fmt.Printf("  math.MinInt64 %% -1 = %d (no panic, unlike C where this is undefined behavior)\n", minInt%minusOne)
+
+	fmt.Println("\nShifting by the Type Width or More:")
+	var u8 uint8 = 1
+	var u32 uint32 = 1
+	var i8 int8 = -1
+	for _, n := range []uint{7, 8, 9} {
+		fmt.Printf("  uint8(1) << %d = %d\n", n, u8<<n)
+	}
+	width, wide := uint(32), uint(10) // variables, so vet doesn't flag the oversized shift
+	fmt.Printf("  uint32(1) << 32 = %d (every bit shifted out, result is 0 - not undefined like C)\n", u32<<width)
+	fmt.Printf("  int8(-1) >> 10 = %d (sign bit keeps filling in)\n", i8>>wide)
+
+	fmt.Println("\nArithmetic vs Logical Right Shift:")
+	var signed int8 = -16           // 0b11110000 in two's complement
+	var unsigned uint8 = 0b11110000 // same bits, different type
+	fmt.Printf("  %-16s | %-10s | %-10s | %s\n", "Expression", "Before", "After", "Kind")
+	fmt.Printf("  %-16s | %08b   | %08b   | %s\n", "int8(-16) >> 2", uint8(signed), uint8(signed>>2), "arithmetic (copies sign bit)")
+	fmt.Printf("  %-16s | %08b   | %08b   | %s\n", "uint8(240) >> 2", unsigned, unsigned>>2, "logical (fills with 0)")
+	fmt.Printf("  int8(-16) >> 2 = %d, uint8(240) >> 2 = %d\n", signed>>2, unsigned>>2)
+
+	fmt.Println("\nNegative Shift Counts:")
+	count := -1 // again a variable; a negative constant count won't compile
+	fmt.Printf("  1 << count (count = -1) → %s\n", safeCall(func() string { return fmt.Sprint(1 << count) }))
+	fmt.Printf("  8 >> count (count = -1) → %s\n", safeCall(func() string { return fmt.Sprint(8 >> count) }))
+}
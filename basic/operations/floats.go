@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// specialFloatsSection covers the IEEE 754 values that ordinary float math
+// never shows: NaN, ±Inf and negative zero, plus the traps they set for
+// comparisons, maps, sorting and min/max.
+func specialFloatsSection() {
+	fmt.Println("\n\033[1;34m13. SPECIAL FLOAT VALUES (NaN, ±Inf, -0.0)\033[0m")
+	fmt.Println(strings.Repeat("-", 60))
+
+	nan := math.NaN()
+	posInf := math.Inf(1)
+	negInf := math.Inf(-1)
+	negZero := math.Copysign(0, -1) // the literal -0.0 is just 0 in Go, so build it
+	posZero := 0.0
+
+	fmt.Println("Producing Special Values:")
+	fmt.Printf("  math.NaN()          → %v\n", nan)
+	fmt.Printf("  math.Inf(1)         → %v\n", posInf)
+	fmt.Printf("  math.Inf(-1)        → %v\n", negInf)
+	fmt.Printf("  math.Copysign(0, -1) → %v (prints like 0, but the sign bit is set)\n", negZero)
+	fmt.Printf("  -0.0 as a literal   → %v, Signbit = %v (constant negation of 0 is still +0)\n", -0.0, math.Signbit(-0.0))
+
+	fmt.Println("\nWhat fmt Prints:")
+	fmt.Printf("  %-8s | %-6s | %-10s | %-14s | %-6s | %-20s\n", "Value", "%v", "%.2f", "%e", "%+v", "%b")
+	for _, v := range []struct {
+		name string
+		f    float64
+	}{{"NaN", nan}, {"+Inf", posInf}, {"-Inf", negInf}, {"-0.0", negZero}, {"+0.0", posZero}} {
+		fmt.Printf("  %-8s | %-6v | %-10.2f | %-14e | %-6v | %-20b\n", v.name, v.f, v.f, v.f, fmt.Sprintf("%+v", v.f), v.f)
+	}
+
+	fmt.Println("\nComparison Traps:")
+	fmt.Printf("  NaN == NaN  = %v (NaN is not equal to anything, including itself)\n", nan == nan)
+	fmt.Printf("  NaN != NaN  = %v\n", nan != nan)
+	fmt.Printf("  NaN < 1     = %v, NaN > 1 = %v, NaN == 1 = %v (every ordered comparison is false)\n", nan < 1, nan > 1, nan == 1)
+	fmt.Printf("  -0.0 == 0.0 = %v (equal, even though the bits differ)\n", negZero == posZero)
+	fmt.Printf("  1 / -0.0    = %v, 1 / 0.0 = %v (but they don't behave the same)\n", 1/negZero, 1/posZero)
+	fmt.Printf("  -Inf < -1e308 < +Inf = %v\n", negInf < -1e308 && -1e308 < posInf)
+	fmt.Printf("  +Inf - +Inf = %v, 0 * +Inf = %v (undefined results become NaN)\n", posInf-posInf, 0*posInf)
+
+	fmt.Println("\nDetecting Them Properly:")
+	fmt.Printf("  math.IsNaN(NaN)      = %v  (the only reliable NaN check, or x != x)\n", math.IsNaN(nan))
+	fmt.Printf("  math.IsInf(+Inf, 1)  = %v, math.IsInf(-Inf, 0) = %v (0 means either sign)\n", math.IsInf(posInf, 1), math.IsInf(negInf, 0))
+	fmt.Printf("  math.Signbit(-0.0)   = %v, math.Signbit(0.0) = %v\n", math.Signbit(negZero), math.Signbit(posZero))
+
+	fmt.Println("\nNaN as a Map Key (you can put it in, but never get it out):")
+	m := map[float64]string{}
+	m[nan] = "first"
+	m[nan] = "second" // not an overwrite: this NaN doesn't equal the last one
+	m[negZero] = "negative zero"
+	m[posZero] = "positive zero" // this IS an overwrite: -0.0 == 0.0
+	_, found := m[nan]
+	fmt.Printf("  After m[NaN]=\"first\", m[NaN]=\"second\", m[-0.0]=..., m[0.0]=...: len(m) = %d\n", len(m))
+	fmt.Printf("  _, ok := m[NaN] → ok = %v\n", found)
+	fmt.Printf("  m[0.0] = %q (the -0.0 entry was overwritten)\n", m[posZero])
+	delete(m, nan)
+	fmt.Printf("  After delete(m, NaN): len(m) = %d (nothing deleted)\n", len(m))
+	clear(m)
+	fmt.Printf("  After clear(m): len(m) = %d (Go 1.21's clear is the only way out)\n", len(m))
+
+	fmt.Println("\nSorting Slices That Contain NaN:")
+	data := []float64{3, nan, 1, negInf, 2, nan, posInf, negZero}
+	fmt.Printf("  Input:                         %v\n", data)
+
+	a := slices.Clone(data)
+	slices.Sort(a)
+	fmt.Printf("  slices.Sort:                   %v (NaNs first, then ordered)\n", a)
+
+	b := slices.Clone(data)
+	sort.Float64s(b)
+	fmt.Printf("  sort.Float64s:                 %v (same rule, older API)\n", b)
+
+	c := slices.Clone(data)
+	sort.Slice(c, func(i, j int) bool { return c[i] < c[j] })
+	fmt.Printf("  sort.Slice with plain <:       %v (sorted = %v)\n", c, sort.Float64sAreSorted(c))
+	fmt.Println("  \033[90m(a naive < is not a strict weak ordering once NaN appears, so the result depends on where the NaNs started)\033[0m")
+	fmt.Printf("  slices.Index(data, NaN) = %d, slices.Contains(data, NaN) = %v\n", slices.Index(data, nan), slices.Contains(data, nan))
+	fmt.Printf("  slices.IndexFunc(data, math.IsNaN) = %d\n", slices.IndexFunc(data, math.IsNaN))
+
+	fmt.Println("\nmin, max and NaN:")
+	fmt.Printf("  min(1.0, NaN)       = %v (built-in min/max return NaN if any argument is NaN)\n", min(1.0, nan))
+	fmt.Printf("  max(NaN, +Inf)      = %v\n", max(nan, posInf))
+	fmt.Printf("  math.Min(1, NaN)    = %v, math.Max(NaN, 1) = %v\n", math.Min(1, nan), math.Max(nan, 1))
+	fmt.Printf("  min(-0.0, 0.0)      = %v, Signbit = %v (negative zero counts as smaller)\n", min(negZero, posZero), math.Signbit(min(negZero, posZero)))
+	fmt.Printf("  slices.Max(data)    = %v (slices.Max propagates NaN too)\n", slices.Max(data))
+}
@@ -1,12 +1,20 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"math"
 	"strings"
 )
 
 func main() {
+	inspect := flag.String("inspect", "", "inspect the UTF-8 bytes, runes and grapheme clusters of a string and exit")
+	flag.Parse()
+	if *inspect != "" {
+		inspectString(*inspect)
+		return
+	}
+
 	separator := strings.Repeat("=", 60)
 	fmt.Println(separator)
 	fmt.Println("         GO OPERATIONS REFERENCE GUIDE")
@@ -58,6 +66,9 @@ func main() {
 	fmt.Printf("  f1 * f2 = %.2f\n", f1*f2)
 	fmt.Printf("  f1 / f2 = %.2f\n", f1/f2)
 
+	// Division, modulus and shifts with negative and edge operands (see division.go)
+	divisionEdgeCases()
+
 	// ============================================================
 	// SECTION 2: RELATIONAL (COMPARISON) OPERATIONS
 	// ============================================================
@@ -162,6 +173,11 @@ func main() {
 	fmt.Printf("  strings.Contains(\"%s\", \"prog\") = %v\n", text2, strings.Contains(text2, "prog"))
 	fmt.Printf("  strings.Index(\"%s\", \"prog\") = %d\n", text2, strings.Index(text2, "prog"))
 
+	fmt.Println("\nBeyond ASCII (see utf8.go - try: go run . -inspect \"your text\"):")
+	fmt.Println("  \"GOLANG\" and \"Programming\" are ASCII, so 1 byte = 1 character. That breaks here:")
+	inspectString("Go é 👍🏽 👩‍💻 🇯🇵")
+	utf8Pitfalls()
+
 	// ============================================================
 	// SECTION 6: ARRAY OPERATIONS
 	// ============================================================
@@ -353,6 +369,16 @@ func main() {
 	}
 	fmt.Printf("  All numbers: %v\n  Even numbers: %v\n", allNums, evenNums)
 
+	// ============================================================
+	// SECTION 12: STRCONV CONVERSIONS (see strconv.go)
+	// ============================================================
+	strconvSection()
+
+	// ============================================================
+	// SECTION 13: SPECIAL FLOAT VALUES (see floats.go)
+	// ============================================================
+	specialFloatsSection()
+
 	fmt.Println("\n" + separator)
 	fmt.Println("              END OF REFERENCE GUIDE")
 	fmt.Println(separator + "\n")
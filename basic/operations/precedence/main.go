@@ -0,0 +1,340 @@
+// Command precedence parses a Go expression with go/parser and draws the tree
+// the compiler actually builds, so you can see how operators group without
+// guessing. Every node is labelled with its precedence level and evaluated value.
+//
+// Usage:
+//
+//	go run ./precedence
+//	go run ./precedence -vars "a=1,b=2,c=3,d=25,e=false" "a + b * c << 2 == d && !e"
+//	go run ./precedence -- "-a * 3"   (use -- when the expression starts with -)
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+)
+
+const defaultExpr = "a + b * c << 2 == d && !e"
+
+// unaryOps lists every prefix operator Go accepts. They all bind tighter
+// than any binary operator, so they share a single level above 5.
+var unaryOps = []struct {
+	op      token.Token
+	meaning string
+}{
+	{token.ADD, "unary plus"},
+	{token.SUB, "negation"},
+	{token.NOT, "logical NOT"},
+	{token.XOR, "bitwise complement"},
+	{token.MUL, "pointer dereference"},
+	{token.AND, "address-of"},
+	{token.ARROW, "channel receive"},
+}
+
+func main() {
+	vars := flag.String("vars", "a=1,b=2,c=3,d=25,e=false", "comma-separated name=value bindings (integers, floats or bools)")
+	flag.Parse()
+
+	expr := defaultExpr
+	if flag.NArg() > 0 {
+		expr = strings.Join(flag.Args(), " ")
+	}
+
+	env, err := parseVars(*vars)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "\033[1;31m✗ %v\033[0m\n", err)
+		os.Exit(2)
+	}
+
+	fmt.Printf("\n\033[1;36m╔════════════════════════════════════════════════════════════════╗\033[0m\n")
+	fmt.Printf("\033[1;36m║                 GO OPERATOR PRECEDENCE EXPLORER                ║\033[0m\n")
+	fmt.Printf("\033[1;36m╚════════════════════════════════════════════════════════════════╝\033[0m\n")
+
+	printReference()
+
+	tree, err := parser.ParseExpr(expr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "\033[1;31m✗ cannot parse %q: %v\033[0m\n", expr, err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("\n\033[1;33m▶ EVALUATION TREE\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", strings.Repeat("─", 77))
+	fmt.Printf("Expression:    %s\n", expr)
+	fmt.Printf("Variables:     %s\n", formatEnv(env))
+	fmt.Printf("Grouped as:    %s\n", parenthesize(tree))
+	fmt.Printf("\033[90m%s\033[0m\n", strings.Repeat("─", 77))
+
+	root := build(tree, env)
+	root.print("", true, true)
+
+	if root.err == nil {
+		fmt.Printf("\n\033[1;32m✓ Result: %s\033[0m\n\n", root.value)
+	} else {
+		fmt.Printf("\n\033[1;31m✗ %v\033[0m\n\n", root.err)
+	}
+}
+
+// printReference prints every binary operator grouped by level, reading the
+// levels straight from go/token so the table can't drift from the compiler.
+func printReference() {
+	byLevel := map[int][]string{}
+	for t := token.Token(0); t < 128; t++ {
+		if t.IsOperator() && t.Precedence() > token.LowestPrec {
+			byLevel[t.Precedence()] = append(byLevel[t.Precedence()], t.String())
+		}
+	}
+	levels := make([]int, 0, len(byLevel))
+	for level := range byLevel {
+		levels = append(levels, level)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(levels)))
+
+	meaning := map[int]string{
+		5: "multiplicative, shifts, bit clear",
+		4: "additive, bitwise OR/XOR",
+		3: "comparison",
+		2: "logical AND",
+		1: "logical OR",
+	}
+
+	fmt.Printf("\n\033[1;33m▶ OPERATOR PRECEDENCE (higher binds tighter)\033[0m\n")
+	fmt.Printf("\033[90m%s\033[0m\n", strings.Repeat("─", 77))
+	fmt.Printf("%-8s | %-35s | %-30s\n", "Level", "Operators", "Category")
+	fmt.Printf("\033[90m%s\033[0m\n", strings.Repeat("─", 77))
+
+	unary := make([]string, len(unaryOps))
+	for i, u := range unaryOps {
+		unary[i] = u.op.String()
+	}
+	fmt.Printf("%-8s | %-35s | %-30s\n", "unary", strings.Join(unary, "  "), "prefix operators (bind tightest)")
+	for _, level := range levels {
+		fmt.Printf("%-8d | %-35s | %-30s\n", level, strings.Join(byLevel[level], "  "), meaning[level])
+	}
+	fmt.Println("\nOperators on the same level group left to right: a - b + c == (a - b) + c")
+	fmt.Println("Unary operators:")
+	for _, u := range unaryOps {
+		fmt.Printf("  %-3s %s\n", u.op, u.meaning)
+	}
+}
+
+// node is one box in the evaluation tree.
+type node struct {
+	label    string
+	prec     int // 0 for leaves, 6 for unary operators
+	value    constant.Value
+	err      error
+	children []*node
+}
+
+// build walks the AST bottom-up, evaluating each subexpression with
+// go/constant so integers, floats and bools all share one code path.
+func build(e ast.Expr, env map[string]constant.Value) *node {
+	switch e := e.(type) {
+	case *ast.ParenExpr:
+		inner := build(e.X, env)
+		return &node{label: "( )", value: inner.value, err: inner.err, children: []*node{inner}}
+
+	case *ast.BasicLit:
+		v := constant.MakeFromLiteral(e.Value, e.Kind, 0)
+		if v.Kind() == constant.Unknown {
+			return &node{label: e.Value, err: fmt.Errorf("unsupported literal %s", e.Value)}
+		}
+		return &node{label: e.Value, value: v}
+
+	case *ast.Ident:
+		if v, ok := env[e.Name]; ok {
+			return &node{label: e.Name, value: v}
+		}
+		switch e.Name {
+		case "true":
+			return &node{label: e.Name, value: constant.MakeBool(true)}
+		case "false":
+			return &node{label: e.Name, value: constant.MakeBool(false)}
+		}
+		return &node{label: e.Name, err: fmt.Errorf("undefined: %s (bind it with -vars)", e.Name)}
+
+	case *ast.UnaryExpr:
+		x := build(e.X, env)
+		n := &node{label: e.Op.String(), prec: token.UnaryPrec, children: []*node{x}}
+		if n.err = x.err; n.err == nil {
+			n.value, n.err = evalUnary(e.Op, x.value)
+		}
+		return n
+
+	case *ast.StarExpr:
+		// go/parser produces StarExpr, not UnaryExpr, for a dereference.
+		x := build(e.X, env)
+		n := &node{label: token.MUL.String(), prec: token.UnaryPrec, children: []*node{x}}
+		if n.err = x.err; n.err == nil {
+			n.value, n.err = evalUnary(token.MUL, x.value)
+		}
+		return n
+
+	case *ast.BinaryExpr:
+		x, y := build(e.X, env), build(e.Y, env)
+		n := &node{label: e.Op.String(), prec: e.Op.Precedence(), children: []*node{x, y}}
+		switch {
+		case x.err != nil:
+			n.err = x.err
+		case y.err != nil:
+			n.err = y.err
+		default:
+			n.value, n.err = evalBinary(e.Op, x.value, y.value)
+		}
+		return n
+	}
+	return &node{label: fmt.Sprintf("%T", e), err: fmt.Errorf("unsupported expression %T", e)}
+}
+
+// evalUnary applies a prefix operator. Pointer and channel operators parse
+// fine but have no meaning for plain values, so they are reported instead.
+func evalUnary(op token.Token, x constant.Value) (v constant.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid operation %s%s", op, x)
+		}
+	}()
+	switch op {
+	case token.ADD, token.SUB, token.NOT, token.XOR:
+		return constant.UnaryOp(op, x, 0), nil
+	}
+	return nil, fmt.Errorf("operator %s needs a pointer or channel, not %s", op, x)
+}
+
+// evalBinary applies an infix operator. go/constant panics on mismatched
+// kinds (say, 1 + true), which we turn into a normal error.
+func evalBinary(op token.Token, x, y constant.Value) (v constant.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid operation %s %s %s", x, op, y)
+		}
+	}()
+	switch op {
+	case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+		return constant.MakeBool(constant.Compare(x, op, y)), nil
+	case token.SHL, token.SHR:
+		s, ok := constant.Uint64Val(y)
+		if !ok {
+			return nil, fmt.Errorf("invalid shift count %s", y)
+		}
+		return constant.Shift(x, op, uint(s)), nil
+	case token.QUO:
+		if constant.Sign(y) == 0 {
+			return nil, fmt.Errorf("division by zero in %s / %s", x, y)
+		}
+		if x.Kind() == constant.Int && y.Kind() == constant.Int {
+			op = token.QUO_ASSIGN // go/constant's spelling of integer division
+		}
+	case token.REM:
+		if constant.Sign(y) == 0 {
+			return nil, fmt.Errorf("division by zero in %s %% %s", x, y)
+		}
+	}
+	return constant.BinaryOp(x, op, y), nil
+}
+
+// print draws the node and its children with box-drawing connectors.
+func (n *node) print(prefix string, last, root bool) {
+	connector := "├── "
+	childPrefix := prefix + "│   "
+	if last {
+		connector = "└── "
+		childPrefix = prefix + "    "
+	}
+	if root {
+		connector, childPrefix = "", ""
+	}
+
+	result := "\033[31m✗\033[0m"
+	if n.err == nil {
+		result = "\033[1;32m" + n.value.String() + "\033[0m"
+	}
+
+	switch {
+	case n.prec == token.UnaryPrec:
+		fmt.Printf("%s%s\033[1;35m%s\033[0m \033[90m[unary]\033[0m = %s\n", prefix, connector, n.label, result)
+	case n.prec > 0:
+		fmt.Printf("%s%s\033[1;35m%s\033[0m \033[90m[level %d]\033[0m = %s\n", prefix, connector, n.label, n.prec, result)
+	case len(n.children) > 0:
+		fmt.Printf("%s%s\033[36m%s\033[0m = %s\n", prefix, connector, n.label, result)
+	default:
+		fmt.Printf("%s%s%s = %s\n", prefix, connector, n.label, result)
+	}
+
+	for i, child := range n.children {
+		child.print(childPrefix, i == len(n.children)-1, false)
+	}
+}
+
+// parenthesize renders the expression with every implicit grouping made
+// explicit, which is the whole point of this tool in one line.
+func parenthesize(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.ParenExpr:
+		return parenthesize(e.X)
+	case *ast.BinaryExpr:
+		return "(" + parenthesize(e.X) + " " + e.Op.String() + " " + parenthesize(e.Y) + ")"
+	case *ast.UnaryExpr:
+		return e.Op.String() + parenthesize(e.X)
+	case *ast.StarExpr:
+		return "*" + parenthesize(e.X)
+	case *ast.BasicLit:
+		return e.Value
+	case *ast.Ident:
+		return e.Name
+	}
+	return fmt.Sprintf("%T", e)
+}
+
+// parseVars turns "a=1,e=false" into constant values.
+func parseVars(s string) (map[string]constant.Value, error) {
+	env := map[string]constant.Value{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, raw, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("bad binding %q, want name=value", pair)
+		}
+		name, raw = strings.TrimSpace(name), strings.TrimSpace(raw)
+		switch raw {
+		case "true", "false":
+			env[name] = constant.MakeBool(raw == "true")
+			continue
+		}
+		lit, err := parser.ParseExpr(raw)
+		if err != nil {
+			return nil, fmt.Errorf("bad value for %s: %q", name, raw)
+		}
+		v := build(lit, nil)
+		if v.err != nil {
+			return nil, fmt.Errorf("bad value for %s: %v", name, v.err)
+		}
+		env[name] = v.value
+	}
+	return env, nil
+}
+
+// formatEnv prints the bindings in a stable order.
+func formatEnv(env map[string]constant.Value) string {
+	names := make([]string, 0, len(env))
+	for name := range env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = name + "=" + env[name].String()
+	}
+	return strings.Join(parts, ", ")
+}
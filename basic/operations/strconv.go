@@ -0,0 +1,112 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// strconvSection shows the error-returning conversions from the strconv
+// package. Unlike fmt.Sprintf, every Parse* function can fail, so each example
+// prints the error it gets back instead of pretending the input is always valid.
+func strconvSection() {
+	fmt.Println("\n\033[1;34m12. STRCONV CONVERSIONS (with real error handling)\033[0m")
+	fmt.Println(strings.Repeat("-", 60))
+
+	fmt.Println("String to Integer (strconv.Atoi):")
+	for _, input := range []string{"42", "-17", "12abc", "99999999999999999999"} {
+		n, err := strconv.Atoi(input)
+		if err != nil {
+			fmt.Printf("  Atoi(%q) → \033[31merror: %v\033[0m\n", input, err)
+			continue
+		}
+		fmt.Printf("  Atoi(%q) → %d\n", input, n)
+	}
+
+	fmt.Println("\nParseInt with Base and Bit Size (strconv.ParseInt(s, base, bitSize)):")
+	parseIntCases := []struct {
+		input   string
+		base    int
+		bitSize int
+	}{
+		{"ff", 16, 64},       // hexadecimal
+		{"-101", 2, 8},       // binary, fits in int8
+		{"0x1F", 0, 64},      // base 0 reads the prefix (0x, 0o, 0b)
+		{"1_000_000", 0, 64}, // underscores are only allowed with base 0
+		{"300", 10, 8},       // does not fit in int8
+		{"z", 10, 64},        // not a digit in base 10
+	}
+	for _, c := range parseIntCases {
+		n, err := strconv.ParseInt(c.input, c.base, c.bitSize)
+		if err != nil {
+			fmt.Printf("  ParseInt(%q, %d, %d) → %d, \033[31merror: %v\033[0m\n", c.input, c.base, c.bitSize, n, err)
+			continue
+		}
+		fmt.Printf("  ParseInt(%q, %d, %d) → %d\n", c.input, c.base, c.bitSize, n)
+	}
+
+	fmt.Println("\nString to Float (strconv.ParseFloat):")
+	for _, input := range []string{"3.14159", "1e-3", "1e400", "NaN", "three"} {
+		f, err := strconv.ParseFloat(input, 64)
+		if err != nil {
+			fmt.Printf("  ParseFloat(%q, 64) → %v, \033[31merror: %v\033[0m\n", input, f, err)
+			continue
+		}
+		fmt.Printf("  ParseFloat(%q, 64) → %v\n", input, f)
+	}
+
+	fmt.Println("\nString to Bool (strconv.ParseBool):")
+	for _, input := range []string{"true", "T", "0", "FALSE", "yes"} {
+		b, err := strconv.ParseBool(input)
+		if err != nil {
+			fmt.Printf("  ParseBool(%q) → %v, \033[31merror: %v\033[0m\n", input, b, err)
+			continue
+		}
+		fmt.Printf("  ParseBool(%q) → %v\n", input, b)
+	}
+
+	fmt.Println("\nNumber to String (strconv.FormatInt / Itoa):")
+	fmt.Printf("  strconv.Itoa(123)         = %q\n", strconv.Itoa(123))
+	fmt.Printf("  strconv.FormatInt(255, 2)  = %q (binary)\n", strconv.FormatInt(255, 2))
+	fmt.Printf("  strconv.FormatInt(255, 16) = %q (hex)\n", strconv.FormatInt(255, 16))
+	fmt.Printf("  strconv.FormatInt(-35, 36) = %q (base 36)\n", strconv.FormatInt(-35, 36))
+	fmt.Printf("  strconv.FormatFloat(45.67, 'f', 1, 64) = %q\n", strconv.FormatFloat(45.67, 'f', 1, 64))
+
+	fmt.Println("\nQuoting (strconv.Quote):")
+	raw := "Hello, 世界\n\t\"Go\""
+	fmt.Printf("  strconv.Quote        → %s\n", strconv.Quote(raw))
+	fmt.Printf("  strconv.QuoteToASCII → %s\n", strconv.QuoteToASCII(raw))
+	unquoted, err := strconv.Unquote(`"tab:\t end"`)
+	fmt.Printf("  strconv.Unquote(`\"tab:\\t end\"`) → %q, err = %v\n", unquoted, err)
+
+	fmt.Println("\nAppending Without Allocating (strconv.AppendInt):")
+	buf := make([]byte, 0, 32)
+	buf = append(buf, "id="...)
+	buf = strconv.AppendInt(buf, 1024, 10)
+	buf = append(buf, " mask=0b"...)
+	buf = strconv.AppendInt(buf, 5, 2)
+	buf = append(buf, " ok="...)
+	buf = strconv.AppendBool(buf, true)
+	fmt.Printf("  buf = %q (len %d, cap %d)\n", buf, len(buf), cap(buf))
+
+	fmt.Println("\nInspecting Errors (*strconv.NumError, errors.Is, errors.As):")
+	inspectInputs := []string{"128", "12.5", ""}
+	for _, input := range inspectInputs {
+		_, err := strconv.ParseInt(input, 10, 8)
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) {
+			continue
+		}
+		fmt.Printf("  ParseInt(%q, 10, 8):\n", input)
+		fmt.Printf("    Func = %s, Num = %q, Err = %v\n", numErr.Func, numErr.Num, numErr.Err)
+		switch {
+		case errors.Is(err, strconv.ErrRange):
+			fmt.Println("    errors.Is(err, strconv.ErrRange)  = true → value is valid but too big")
+		case errors.Is(err, strconv.ErrSyntax):
+			fmt.Println("    errors.Is(err, strconv.ErrSyntax) = true → not a number at all")
+		}
+	}
+
+	fmt.Println("\nTiming strconv vs fmt.Sprintf: go test -bench . -benchmem (strconv_test.go)")
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+// Sinks keep the compiler from optimising the benchmarked calls away.
+// They're typed, so storing a result doesn't box it into an allocation.
+var (
+	sinkString string
+	sinkBytes  []byte
+	sinkInt    int
+)
+
+func BenchmarkItoa(b *testing.B) {
+	for i := range b.N {
+		sinkString = strconv.Itoa(i)
+	}
+}
+
+func BenchmarkAppendInt(b *testing.B) {
+	buf := make([]byte, 0, 20)
+	for i := range b.N {
+		buf = strconv.AppendInt(buf[:0], int64(i), 10)
+	}
+	sinkBytes = buf
+}
+
+func BenchmarkSprintf(b *testing.B) {
+	for i := range b.N {
+		sinkString = fmt.Sprintf("%d", i)
+	}
+}
+
+func BenchmarkAtoi(b *testing.B) {
+	for range b.N {
+		n, _ := strconv.Atoi("123456")
+		sinkInt = n
+	}
+}
+
+func BenchmarkSscanf(b *testing.B) {
+	var n int
+	for range b.N {
+		_, _ = fmt.Sscanf("123456", "%d", &n)
+	}
+	sinkInt = n
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Code points that glue neighbouring runes into one user-perceived character.
+const (
+	zeroWidthJoiner   = '\u200D'
+	variationSelector = '\uFE0F'
+)
+
+// graphemeStarts returns the byte offsets where each user-perceived
+// character ("grapheme cluster") begins. It implements the common subset of
+// Unicode's UAX #29 rules, enough for accents, emoji modifiers, ZWJ emoji
+// sequences and flags; the full algorithm lives in golang.org/x/text.
+func graphemeStarts(s string) []int {
+	var starts []int
+	var prev rune
+	regionalRun := 0 // regional indicators pair up into flags
+	for i, r := range s {
+		extends := i > 0 && (unicode.In(r, unicode.Mn, unicode.Me) || // combining accents
+			r == zeroWidthJoiner || r == variationSelector ||
+			(r >= 0x1F3FB && r <= 0x1F3FF) || // skin tone modifiers
+			prev == zeroWidthJoiner || // whatever follows a ZWJ joins it
+			(r == '\n' && prev == '\r') ||
+			(isRegionalIndicator(r) && regionalRun%2 == 1))
+		if !extends {
+			starts = append(starts, i)
+		}
+		if isRegionalIndicator(r) {
+			regionalRun++
+		} else {
+			regionalRun = 0
+		}
+		prev = r
+	}
+	return starts
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// inspectString prints, for every byte of s, the rune it belongs to, that
+// rune's code point and encoded width, and where grapheme clusters begin.
+func inspectString(s string) {
+	starts := graphemeStarts(s)
+	clusterAt := map[int]int{}
+	for n, off := range starts {
+		clusterAt[off] = n
+	}
+
+	fmt.Printf("  Input: %q\n", s)
+	fmt.Printf("  %-5s | %-4s | %-6s | %-8s | %-9s | %-5s | %-13s | %s\n", "Byte", "Hex", "Rune #", "Char", "Code Pt", "Width", "Byte Role", "Grapheme Cluster")
+	fmt.Println("  " + strings.Repeat("-", 85))
+
+	runeIndex, cluster := -1, -1
+	for i := 0; i < len(s); {
+		r, width := utf8.DecodeRuneInString(s[i:])
+		runeIndex++
+		var clusterLabel string
+		if n, ok := clusterAt[i]; ok {
+			cluster = n
+			clusterLabel = fmt.Sprintf("#%d starts", n)
+		} else {
+			clusterLabel = fmt.Sprintf("\033[90m#%d cont.\033[0m", cluster)
+		}
+
+		char := string(r)
+		switch {
+		case r == utf8.RuneError && width == 1:
+			char = "\033[31m(bad)\033[0m   "
+		case !unicode.IsPrint(r) || unicode.In(r, unicode.Mn, unicode.Me):
+			char = fmt.Sprintf("%-8s", "("+shortName(r)+")")
+		default:
+			char = fmt.Sprintf("%-8s", char)
+			if displayWidth(r) == 2 {
+				char = char[:len(char)-1] // wide glyphs take two columns
+			}
+		}
+
+		lead := fmt.Sprintf("lead (%d-byte)", width)
+		if width == 1 {
+			lead = "single byte"
+		}
+		for b := 0; b < width; b++ {
+			if b == 0 {
+				fmt.Printf("  %-5d | %02X   | %-6d | %s | %-9s | %-5d | %-13s | %s\n", i+b, s[i+b], runeIndex, char, fmt.Sprintf("U+%04X", r), width, lead, clusterLabel)
+			} else {
+				fmt.Printf("  %-5d | %02X   | %-6s | %-8s | %-9s | %-5s | %-13s |\n", i+b, s[i+b], "", "", "", "", "continuation")
+			}
+		}
+		i += width
+	}
+
+	fmt.Printf("\n  len(s)                     = %d bytes\n", len(s))
+	fmt.Printf("  utf8.RuneCountInString(s)  = %d runes\n", utf8.RuneCountInString(s))
+	fmt.Printf("  len([]rune(s))             = %d runes\n", len([]rune(s)))
+	fmt.Printf("  grapheme clusters          = %d user-perceived characters\n", len(starts))
+	fmt.Printf("  utf8.ValidString(s)        = %v\n", utf8.ValidString(s))
+}
+
+// shortName labels invisible runes so the table stays readable.
+func shortName(r rune) string {
+	switch {
+	case r == zeroWidthJoiner:
+		return "ZWJ"
+	case r == variationSelector:
+		return "VS16"
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return "accent"
+	case r == '\n':
+		return `\n`
+	case r == '\t':
+		return `\t`
+	}
+	return "ctrl"
+}
+
+// displayWidth is a rough guess at how many terminal columns r occupies:
+// 2 for CJK and emoji, 1 for everything else.
+func displayWidth(r rune) int {
+	if r >= 0x1100 && (unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hangul, r) ||
+		unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) || r >= 0x1F300) {
+		return 2
+	}
+	return 1
+}
+
+// utf8Pitfalls shows the two ways byte-oriented string code goes wrong:
+// slicing through the middle of a rune, and decoding bytes that were never
+// valid UTF-8 in the first place.
+func utf8Pitfalls() {
+	word := "café"
+	fmt.Println("\nSlicing Mid-Rune (indexes are bytes, not characters):")
+	fmt.Printf("  word = %q, len = %d, but only %d runes\n", word, len(word), utf8.RuneCountInString(word))
+	broken := word[:4] // 'é' is 2 bytes (C3 A9); this cuts it in half
+	fmt.Printf("  word[:4] = %q → prints as \"%s\", valid UTF-8: %v\n", broken, broken, utf8.ValidString(broken))
+	fmt.Printf("  word[3] = %d (0x%X), a lone lead byte, not 'é'\n", word[3], word[3])
+	runes := []rune(word)
+	fmt.Printf("  string([]rune(word)[:4]) = %q (convert to runes first to slice by character)\n", string(runes[:4]))
+
+	fmt.Println("\nInvalid UTF-8 Decodes to U+FFFD:")
+	invalid := "ok\xff\xfe!"
+	fmt.Printf("  bytes: % X\n", []byte(invalid))
+	fmt.Print("  range:  ")
+	for i, r := range invalid {
+		fmt.Printf("[%d]=%U ", i, r)
+	}
+	fmt.Println()
+	r, size := utf8.DecodeRuneInString(invalid[2:])
+	fmt.Printf("  utf8.DecodeRuneInString(\"\\xff...\") = %U, size %d (RuneError, advance one byte)\n", r, size)
+	fmt.Printf("  strings.ToValidUTF8(s, \"?\") = %q\n", strings.ToValidUTF8(invalid, "?"))
+}